@@ -0,0 +1,207 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// oauthServer is a stand-in for Linear's authorize and token endpoints.
+// The authorize endpoint approves at once and redirects back with a code.
+type oauthServer struct {
+	*httptest.Server
+
+	mu         sync.Mutex
+	challenge  string
+	stateReply func(state string) string
+	tokenForms []url.Values
+	tokenReply map[string]any
+}
+
+func newOAuthServer(t *testing.T) *oauthServer {
+	s := &oauthServer{
+		stateReply: func(state string) string { return state },
+		tokenReply: map[string]any{"access_token": "a1", "refresh_token": "r1", "token_type": "Bearer", "expires_in": 3600},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/authorize", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("code_challenge_method") != "S256" {
+			t.Errorf("code_challenge_method = %q, want S256", query.Get("code_challenge_method"))
+		}
+		if query.Get("client_id") != "cid" || query.Get("response_type") != "code" {
+			t.Errorf("unexpected authorize query %v", query)
+		}
+		s.mu.Lock()
+		s.challenge = query.Get("code_challenge")
+		s.mu.Unlock()
+		redirect := query.Get("redirect_uri") + "?" + url.Values{
+			"code":  {"code1"},
+			"state": {s.stateReply(query.Get("state"))},
+		}.Encode()
+		http.Redirect(w, r, redirect, http.StatusFound)
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+			return
+		}
+		s.mu.Lock()
+		s.tokenForms = append(s.tokenForms, r.PostForm)
+		s.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(s.tokenReply)
+	})
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *oauthServer) config() *Config {
+	return &Config{
+		ClientID: "cid",
+		AuthURL:  s.URL + "/authorize",
+		TokenURL: s.URL + "/token",
+		Scopes:   []string{"read", "write"},
+	}
+}
+
+// browse plays the browser: it follows the authorization URL and the
+// redirect back to the loopback callback.
+func browse(authURL string) error {
+	resp, err := http.Get(authURL)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func TestChallenge(t *testing.T) {
+	// The example from RFC 7636, appendix B.
+	verifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	if got, want := challenge(verifier), "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"; got != want {
+		t.Errorf("challenge(%q) = %q, want %q", verifier, got, want)
+	}
+}
+
+func TestLogin(t *testing.T) {
+	server := newOAuthServer(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	token, err := Login(ctx, server.config(), browse)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.AccessToken != "a1" || token.RefreshToken != "r1" {
+		t.Errorf("token = %+v", token)
+	}
+	if until := time.Until(token.Expiry); until < 59*time.Minute || until > time.Hour {
+		t.Errorf("token expires in %s, want about an hour", until)
+	}
+
+	if len(server.tokenForms) != 1 {
+		t.Fatalf("token endpoint called %d times, want 1", len(server.tokenForms))
+	}
+	form := server.tokenForms[0]
+	if form.Get("grant_type") != "authorization_code" || form.Get("code") != "code1" || form.Get("client_id") != "cid" {
+		t.Errorf("unexpected token request %v", form)
+	}
+	verifier := form.Get("code_verifier")
+	if len(verifier) < 43 {
+		t.Errorf("code_verifier %q is shorter than the 43 characters PKCE requires", verifier)
+	}
+	if challenge(verifier) != server.challenge {
+		t.Errorf("code_verifier does not match the code_challenge sent to authorize")
+	}
+	if !strings.HasSuffix(form.Get("redirect_uri"), "/callback") {
+		t.Errorf("redirect_uri = %q", form.Get("redirect_uri"))
+	}
+}
+
+func TestLoginRejectsStateMismatch(t *testing.T) {
+	server := newOAuthServer(t)
+	server.stateReply = func(string) string { return "forged" }
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := Login(ctx, server.config(), browse)
+	if err == nil || !strings.Contains(err.Error(), "state mismatch") {
+		t.Fatalf("Login error = %v, want a state mismatch", err)
+	}
+	if len(server.tokenForms) != 0 {
+		t.Errorf("the code was exchanged despite the state mismatch")
+	}
+}
+
+func TestStoreFileMode(t *testing.T) {
+	store := NewStore(filepath.Join(t.TempDir(), "lineartui", "token.json"))
+	token := &Token{AccessToken: "a1", RefreshToken: "r1", Expiry: time.Now().Add(time.Hour).Round(time.Second)}
+	if err := store.Save(token); err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := os.Stat(store.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("token file mode = %#o, want 0600", perm)
+	}
+
+	loaded, err := store.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.AccessToken != token.AccessToken || loaded.RefreshToken != token.RefreshToken || !loaded.Expiry.Equal(token.Expiry) {
+		t.Errorf("loaded %+v, saved %+v", loaded, token)
+	}
+}
+
+func TestTransportRefreshesExpiredToken(t *testing.T) {
+	server := newOAuthServer(t)
+	// Linear may leave the refresh token out when it does not rotate.
+	server.tokenReply = map[string]any{"access_token": "a2", "expires_in": 3600}
+
+	var authorization string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+	}))
+	defer api.Close()
+
+	store := NewStore(filepath.Join(t.TempDir(), "token.json"))
+	if err := store.Save(&Token{AccessToken: "a1", RefreshToken: "r1", Expiry: time.Now().Add(-time.Minute)}); err != nil {
+		t.Fatal(err)
+	}
+	httpClient := &http.Client{Transport: &Transport{Config: server.config(), Store: store}}
+	resp, err := httpClient.Get(api.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if authorization != "Bearer a2" {
+		t.Errorf("Authorization = %q, want the refreshed token", authorization)
+	}
+	if len(server.tokenForms) != 1 {
+		t.Fatalf("token endpoint called %d times, want 1", len(server.tokenForms))
+	}
+	if form := server.tokenForms[0]; form.Get("grant_type") != "refresh_token" || form.Get("refresh_token") != "r1" {
+		t.Errorf("unexpected refresh request %v", form)
+	}
+	saved, err := store.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.AccessToken != "a2" || saved.RefreshToken != "r1" || saved.Expired() {
+		t.Errorf("saved token = %+v, want a2 with refresh token r1", saved)
+	}
+}
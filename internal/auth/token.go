@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// expirySkew refreshes tokens slightly early so a request never races the
+// expiry on the server.
+const expirySkew = time.Minute
+
+type Token struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	TokenType    string    `json:"token_type,omitempty"`
+	Scope        string    `json:"scope,omitempty"`
+	Expiry       time.Time `json:"expiry,omitempty"`
+}
+
+// Expired reports whether the token should be refreshed before use.
+func (t *Token) Expired() bool {
+	if t.Expiry.IsZero() {
+		return false
+	}
+	return time.Now().Add(expirySkew).After(t.Expiry)
+}
+
+// Store persists a token to a single JSON file readable only by the owner.
+type Store struct {
+	Path string
+	mu   sync.Mutex
+}
+
+func NewStore(path string) *Store {
+	return &Store{Path: path}
+}
+
+// Load returns the saved token, or nil when the user has not logged in.
+func (s *Store) Load() (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	raw, err := os.ReadFile(s.Path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read token file: %w", err)
+	}
+	var token Token
+	if err := json.Unmarshal(raw, &token); err != nil {
+		return nil, fmt.Errorf("failed to parse token file %s: %w", s.Path, err)
+	}
+	return &token, nil
+}
+
+func (s *Store) Save(token *Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	raw, err := json.MarshalIndent(token, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode token: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(s.Path), 0o700); err != nil {
+		return fmt.Errorf("failed to create token directory: %w", err)
+	}
+	if err := os.WriteFile(s.Path, raw, 0o600); err != nil {
+		return fmt.Errorf("failed to write token file: %w", err)
+	}
+	return os.Chmod(s.Path, 0o600)
+}
+
+func (s *Store) Delete() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.Remove(s.Path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to remove token file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// Transport adds an OAuth bearer token to each request, refreshing and
+// persisting it first when it has expired.
+type Transport struct {
+	Config *Config
+	Store  *Store
+	Base   http.RoundTripper
+
+	mu    sync.Mutex
+	token *Token
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.currentToken(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// RoundTrippers must not modify the caller's request.
+	clone := req.Clone(req.Context())
+	clone.Header.Set("Authorization", "Bearer "+token.AccessToken)
+
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(clone)
+}
+
+func (t *Transport) currentToken(req *http.Request) (*Token, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.token == nil {
+		token, err := t.Store.Load()
+		if err != nil {
+			return nil, err
+		}
+		if token == nil {
+			return nil, errors.New("not logged in, run 'lineartui auth login'")
+		}
+		t.token = token
+	}
+
+	if t.token.Expired() {
+		token, err := Refresh(req.Context(), t.Config, t.token.RefreshToken)
+		if err != nil {
+			return nil, fmt.Errorf("failed to refresh access token: %w", err)
+		}
+		if err := t.Store.Save(token); err != nil {
+			return nil, err
+		}
+		t.token = token
+	}
+
+	return t.token, nil
+}
@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shurcooL/graphql"
+)
+
+type AttachmentData struct {
+	ID         graphql.String `json:"id"`
+	Title      graphql.String `json:"title"`
+	Subtitle   graphql.String `json:"subtitle"`
+	URL        graphql.String `json:"url"`
+	SourceType graphql.String `json:"sourceType"`
+	CreatedAt  time.Time      `json:"createdAt"`
+	Creator    *UserRef       `json:"creator"`
+}
+
+func (c *client) ListAttachments(ctx context.Context, issueID string) ([]AttachmentData, error) {
+	var query struct {
+		Issue struct {
+			Attachments struct {
+				Nodes []AttachmentData
+			} `graphql:"attachments(first: 250)"`
+		} `graphql:"issue(id: $issueId)"`
+	}
+
+	variables := map[string]any{
+		"issueId": graphql.String(issueID),
+	}
+
+	err := c.gql.Query(ctx, &query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list attachments: %w", err)
+	}
+	return query.Issue.Attachments.Nodes, nil
+}
+
+// UploadFile is where to PUT a file and the URL it is served from once
+// uploaded. Headers must be sent with the PUT.
+type UploadFile struct {
+	UploadURL graphql.String
+	AssetURL  graphql.String
+	Headers   []struct {
+		Key   graphql.String
+		Value graphql.String
+	}
+}
+
+// FileUpload asks Linear for a signed URL to upload a file to.
+func (c *client) FileUpload(ctx context.Context, contentType string, filename string, size int64) (*UploadFile, error) {
+	var mutation struct {
+		FileUpload struct {
+			Success    graphql.Boolean
+			UploadFile UploadFile
+		} `graphql:"fileUpload(contentType: $contentType, filename: $filename, size: $size)"`
+	}
+
+	variables := map[string]any{
+		"contentType": graphql.String(contentType),
+		"filename":    graphql.String(filename),
+		"size":        graphql.Int(size),
+	}
+
+	err := c.gql.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request upload URL: %w", err)
+	}
+	if !mutation.FileUpload.Success {
+		return nil, errors.New("upload URL request was not successful")
+	}
+	return &mutation.FileUpload.UploadFile, nil
+}
+
+// CreateAttachment links url, typically an uploaded asset, to an issue.
+func (c *client) CreateAttachment(ctx context.Context, issueID string, url string, title string) (*AttachmentData, error) {
+	var mutation struct {
+		AttachmentCreate struct {
+			Success    graphql.Boolean
+			Attachment AttachmentData
+		} `graphql:"attachmentCreate(input: $input)"`
+	}
+	type AttachmentCreateInput struct {
+		IssueID graphql.String `json:"issueId"`
+		URL     graphql.String `json:"url"`
+		Title   graphql.String `json:"title"`
+	}
+	variables := map[string]any{
+		"input": AttachmentCreateInput{
+			IssueID: graphql.String(issueID),
+			URL:     graphql.String(url),
+			Title:   graphql.String(title),
+		},
+	}
+
+	err := c.gql.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create attachment: %w", err)
+	}
+	if !mutation.AttachmentCreate.Success {
+		return nil, errors.New("attachment creation was not successful")
+	}
+	return &mutation.AttachmentCreate.Attachment, nil
+}
+
+// LinkURL attaches a link to an issue. Linear recognises links to pull
+// requests, Slack threads and the like and shows them with their source.
+func (c *client) LinkURL(ctx context.Context, issueID string, url string, title string) (*AttachmentData, error) {
+	var mutation struct {
+		AttachmentLinkURL struct {
+			Success    graphql.Boolean
+			Attachment AttachmentData
+		} `graphql:"attachmentLinkURL(issueId: $issueId, url: $url, title: $title)"`
+	}
+	var titleVar *graphql.String
+	if title != "" {
+		titleVar = graphql.NewString(graphql.String(title))
+	}
+	variables := map[string]any{
+		"issueId": graphql.String(issueID),
+		"url":     graphql.String(url),
+		"title":   titleVar,
+	}
+
+	err := c.gql.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to link URL: %w", err)
+	}
+	if !mutation.AttachmentLinkURL.Success {
+		return nil, errors.New("linking the URL was not successful")
+	}
+	return &mutation.AttachmentLinkURL.Attachment, nil
+}
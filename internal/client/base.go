@@ -17,20 +17,63 @@ type Client interface {
 	FindIssueByTitle(ctx context.Context, teamID string, title string) (string, error)
 	FindTeamByName(ctx context.Context, name string) (string, error)
 	AddIssue(ctx context.Context, teamID string, title string, description ...string) (*IssueData, error)
+	CreateIssue(ctx context.Context, input IssueCreateInput) (*IssueData, error)
 	DeleteIssue(ctx context.Context, issueID string) error
-	UpdateAssigneeOnIssue(ctx context.Context, issueID string, assignee string) error
-	UpdateDescriptionOnIssue(ctx context.Context, issueID string, description string) error
-	UpdatePriorityOnIssue(ctx context.Context, issueID string, priority float64) error
+	ArchiveIssue(ctx context.Context, issueID string) error
+	UnarchiveIssue(ctx context.Context, issueID string) error
 	UpdateStatusOnIssue(ctx context.Context, issueID string, status string) error
+	UpdateIssue(ctx context.Context, issueID string, input IssueUpdateInput) (*IssueData, error)
+	UpdateIssues(ctx context.Context, updates []IssueUpdate) []error
+	GetLabels(ctx context.Context) ([]LabelData, error)
+	GetIssueLabels(ctx context.Context, issueID string) ([]LabelData, error)
+	GetIssueLabelSets(ctx context.Context, issueIDs []string) ([][]LabelData, []error)
 	SearchLabel(ctx context.Context, labelName string) (string, error)
 	CreateNewLabel(ctx context.Context, labelName string) (string, error)
-	AddLabeltoIssue(ctx context.Context, issueID string, labelName string) error
-	RemoveLabelFromIssue(ctx context.Context, issueID string, labelName string) error
+	LabelIDs(ctx context.Context, labelNames []string) ([]string, error)
 	ListLabels(ctx context.Context, issueID string) error
+	Viewer(ctx context.Context) (*UserData, error)
+	ListUsers(ctx context.Context) ([]UserData, error)
+	SearchUsers(ctx context.Context, term string) ([]UserData, error)
+	FindProjectByName(ctx context.Context, name string) (string, error)
+	GetWorkflowStates(ctx context.Context, teamID string) ([]WorkflowStateData, error)
+	GetIssueTeamID(ctx context.Context, issueID string) (string, error)
+	GetIssueState(ctx context.Context, issueID string) (*IssueStateData, error)
+	GetIssue(ctx context.Context, issueID string) (*IssueDetail, error)
+	GetIssues(ctx context.Context, issueIDs []string) ([]*IssueDetail, []error)
+	GetIssueRef(ctx context.Context, issueID string) (*IssueRef, error)
+	GetIssueSummary(ctx context.Context, issueID string) (*IssueSummary, error)
+	GetIssueSummaries(ctx context.Context, issueIDs []string) ([]*IssueSummary, []error)
+	ListIssues(ctx context.Context, filter IssueFilter, opts ListOptions) ([]IssueSummary, error)
+	EachIssuePage(ctx context.Context, filter IssueFilter, opts ListOptions, fn func(page []IssueSummary) error) error
+	ListCustomViews(ctx context.Context) ([]CustomViewData, error)
+	ListTemplates(ctx context.Context) ([]TemplateData, error)
+	ListCustomViewIssues(ctx context.Context, viewID string, filter IssueFilter, opts ListOptions) ([]IssueSummary, error)
+	ListComments(ctx context.Context, issueID string) ([]CommentData, error)
+	GetComment(ctx context.Context, commentID string) (*CommentData, error)
+	CreateComment(ctx context.Context, input CommentCreateInput) (*CommentData, error)
+	UpdateComment(ctx context.Context, commentID string, body string) error
+	DeleteComment(ctx context.Context, commentID string) error
+	ResolveComment(ctx context.Context, commentID string, resolved bool) error
+	GetIssueRelations(ctx context.Context, issueID string) ([]IssueRelationData, error)
+	CreateIssueRelation(ctx context.Context, issueID string, relatedIssueID string, relationType string) error
+	DeleteIssueRelation(ctx context.Context, relationID string) error
+	ListIssueBlockers(ctx context.Context, filter IssueFilter) ([]IssueBlockers, error)
+	GetTeamEstimation(ctx context.Context, teamID string) (*TeamEstimation, error)
+	FindCycle(ctx context.Context, teamID string, filter CycleFilter) (*CycleData, error)
+	ListAttachments(ctx context.Context, issueID string) ([]AttachmentData, error)
+	FileUpload(ctx context.Context, contentType string, filename string, size int64) (*UploadFile, error)
+	CreateAttachment(ctx context.Context, issueID string, url string, title string) (*AttachmentData, error)
+	LinkURL(ctx context.Context, issueID string, url string, title string) (*AttachmentData, error)
+	QueryBatch(ctx context.Context, ops []BatchOp) []error
+	MutateBatch(ctx context.Context, ops []BatchOp) []error
 }
 
 type client struct {
 	gql *graphql.Client
+	// httpClient and apiURL are kept for batches, which need the raw
+	// response to tell which operation an error belongs to.
+	httpClient *http.Client
+	apiURL     string
 }
 
 func NewClient(apiKey string, apiURL string) Client {
@@ -41,10 +84,18 @@ func NewClient(apiKey string, apiURL string) Client {
 		},
 	}
 
+	return NewClientWithHTTPClient(httpClient, apiURL)
+}
+
+// NewClientWithHTTPClient builds a client whose httpClient is responsible
+// for authentication, e.g. one using an OAuth transport.
+func NewClientWithHTTPClient(httpClient *http.Client, apiURL string) Client {
 	gqlClient := graphql.NewClient(apiURL, httpClient)
 
 	return &client{
-		gql: gqlClient,
+		gql:        gqlClient,
+		httpClient: httpClient,
+		apiURL:     apiURL,
 	}
 }
 
@@ -68,6 +119,8 @@ type TeamData struct {
 
 type IssueData struct {
 	ID          graphql.String
+	Identifier  graphql.String
+	URL         graphql.String
 	Title       graphql.String
 	Description graphql.String
 	Assignee    struct {
@@ -134,7 +187,7 @@ func (c *client) DisplayIssues(ctx context.Context, teamID string, titlesOnly bo
 		}
 	} else {
 		for _, issue := range team.Issues.Nodes {
-			fmt.Printf("Issue: ID=%s, Title=%s\n", issue.ID, issue.Title)
+			fmt.Printf("Issue: %s, Title=%s\n", issue.Identifier, issue.Title)
 			if issue.Description != "" {
 				fmt.Printf("  Description: %s\n", issue.Description)
 			}
@@ -196,34 +249,52 @@ func (c *client) FindTeamByName(ctx context.Context, name string) (string, error
 	return string(names[0].ID), nil
 }
 
-func (c *client) AddIssue(ctx context.Context, teamID string, title string, description ...string) (*IssueData, error) {
-	if title == "" {
-		return nil, errors.New("title is required")
-	}
+// IssueCreateInput mirrors Linear's input type of the same name; the Go type
+// name is what the graphql package declares the variable as.
+type IssueCreateInput struct {
+	Title       graphql.String   `json:"title,omitempty"`
+	Description graphql.String   `json:"description,omitempty"`
+	TeamID      graphql.String   `json:"teamId"`
+	ProjectID   graphql.String   `json:"projectId,omitempty"`
+	LabelIDs    []graphql.String `json:"labelIds,omitempty"`
+	ParentID    graphql.String   `json:"parentId,omitempty"`
+	Priority    *graphql.Int     `json:"priority,omitempty"`
+	AssigneeID  graphql.String   `json:"assigneeId,omitempty"`
+	Estimate    *graphql.Int     `json:"estimate,omitempty"`
+	DueDate     graphql.String   `json:"dueDate,omitempty"`
+	CycleID     graphql.String   `json:"cycleId,omitempty"`
+	StateID     graphql.String   `json:"stateId,omitempty"`
+	TemplateID  graphql.String   `json:"templateId,omitempty"`
+}
 
+func (c *client) AddIssue(ctx context.Context, teamID string, title string, description ...string) (*IssueData, error) {
 	desc := ""
 	if len(description) > 0 {
 		desc = description[0]
 	}
 
+	return c.CreateIssue(ctx, IssueCreateInput{
+		Title:       graphql.String(title),
+		Description: graphql.String(desc),
+		TeamID:      graphql.String(teamID),
+	})
+}
+
+func (c *client) CreateIssue(ctx context.Context, input IssueCreateInput) (*IssueData, error) {
+	// A template can supply the title.
+	if input.Title == "" && input.TemplateID == "" {
+		return nil, errors.New("title is required")
+	}
+
 	var mutation struct {
 		IssueCreate struct {
 			Success graphql.Boolean `graphql:"success"`
 			Issue   IssueData       `graphql:"issue"`
 		} `graphql:"issueCreate(input: $input)"`
 	}
-	type IssueCreateInput struct {
-		Title       graphql.String `json:"title"`
-		Description graphql.String `json:"description"`
-		TeamID      graphql.String `json:"teamId"`
-	}
 
 	variables := map[string]any{
-		"input": IssueCreateInput{
-			Title:       graphql.String(title),
-			Description: graphql.String(desc),
-			TeamID:      graphql.String(teamID),
-		},
+		"input": input,
 	}
 
 	err := c.gql.Mutate(ctx, &mutation, variables)
@@ -235,7 +306,6 @@ func (c *client) AddIssue(ctx context.Context, teamID string, title string, desc
 		return nil, errors.New("issue creation was not successful")
 	}
 
-	fmt.Printf("Created issue: %s (ID: %s)\n", mutation.IssueCreate.Issue.Title, mutation.IssueCreate.Issue.ID)
 	return &mutation.IssueCreate.Issue, nil
 }
 
@@ -259,88 +329,110 @@ func (c *client) DeleteIssue(ctx context.Context, issueID string) error {
 		return errors.New("issue deletion was not successful")
 	}
 
-	fmt.Printf("Successfully deleted issue: %s\n", issueID)
 	return nil
 }
 
-func (c *client) UpdateAssigneeOnIssue(ctx context.Context, issueID string, assign string) error {
+func (c *client) ArchiveIssue(ctx context.Context, issueID string) error {
 	var mutation struct {
-		IssueUpdate struct {
-			Success graphql.Boolean `graphql:"success"`
-		} `graphql:"issueUpdate(id: $issueUpdateId, input: $input)"`
-	}
-
-	type IssueUpdateInput struct {
-		AssigneeId graphql.String `json:"assigneeId"`
+		IssueArchive struct {
+			Success graphql.Boolean
+		} `graphql:"issueArchive(id: $id)"`
 	}
 
 	variables := map[string]any{
-		"issueUpdateId": graphql.String(issueID),
-		"input": IssueUpdateInput{
-			AssigneeId: graphql.String(assign),
-		},
+		"id": graphql.String(issueID),
 	}
+
 	err := c.gql.Mutate(ctx, &mutation, variables)
 	if err != nil {
-		return fmt.Errorf("failed to update issue assignee: %w", err)
+		return fmt.Errorf("failed to archive issue: %w", err)
 	}
-	if !mutation.IssueUpdate.Success {
-		return errors.New("issue assignee update was not successful")
+	if !mutation.IssueArchive.Success {
+		return errors.New("issue archiving was not successful")
 	}
-	fmt.Printf("Successfully updated assignee %s to issue %s\n", assign, issueID)
 	return nil
 }
 
-func (c *client) UpdateDescriptionOnIssue(ctx context.Context, issueID string, description string) error {
+// UnarchiveIssue brings back an archived issue. Deleted issues are archived
+// in the trash, so this also restores them within the grace period.
+func (c *client) UnarchiveIssue(ctx context.Context, issueID string) error {
 	var mutation struct {
-		IssueUpdate struct {
-			Success graphql.Boolean `graphql:"success"`
-		} `graphql:"issueUpdate(id: $issueUpdateId, input: $input)"`
-	}
-	type IssueUpdateInput struct {
-		Description graphql.String `json:"description"`
+		IssueUnarchive struct {
+			Success graphql.Boolean
+		} `graphql:"issueUnarchive(id: $id)"`
 	}
+
 	variables := map[string]any{
-		"issueUpdateId": graphql.String(issueID),
-		"input": IssueUpdateInput{
-			Description: graphql.String(description),
-		},
+		"id": graphql.String(issueID),
 	}
+
 	err := c.gql.Mutate(ctx, &mutation, variables)
 	if err != nil {
-		return fmt.Errorf("failed to update issue description: %w", err)
+		return fmt.Errorf("failed to unarchive issue: %w", err)
 	}
-	if !mutation.IssueUpdate.Success {
-		return errors.New("issue description update was not successful")
+	if !mutation.IssueUnarchive.Success {
+		return errors.New("issue unarchiving was not successful")
 	}
-	fmt.Printf("Successfully updated description to issue %s\n", issueID)
 	return nil
 }
 
-func (c *client) UpdatePriorityOnIssue(ctx context.Context, issueID string, priority float64) error {
+// IssueUpdateInput is Linear's input type of the same name. Only the keys
+// present are changed, and a nil value clears the field, so several fields
+// can be set in one mutation without touching the rest.
+type IssueUpdateInput map[string]any
+
+func (c *client) UpdateIssue(ctx context.Context, issueID string, input IssueUpdateInput) (*IssueData, error) {
 	var mutation struct {
 		IssueUpdate struct {
 			Success graphql.Boolean `graphql:"success"`
+			Issue   IssueData       `graphql:"issue"`
 		} `graphql:"issueUpdate(id: $issueUpdateId, input: $input)"`
 	}
-	type IssueUpdateInput struct {
-		Priority graphql.Float `json:"priority"`
-	}
 	variables := map[string]any{
 		"issueUpdateId": graphql.String(issueID),
-		"input": IssueUpdateInput{
-			Priority: graphql.Float(priority),
-		},
+		"input":         input,
 	}
 	err := c.gql.Mutate(ctx, &mutation, variables)
 	if err != nil {
-		return fmt.Errorf("failed to update issue priority: %w\n", err)
+		return nil, fmt.Errorf("failed to update issue: %w", err)
 	}
 	if !mutation.IssueUpdate.Success {
-		return errors.New("issue priority update was not successful")
+		return nil, errors.New("issue update was not successful")
 	}
-	fmt.Printf("Successfully updated priority %d to issue %s\n", int(priority), issueID)
-	return nil
+	return &mutation.IssueUpdate.Issue, nil
+}
+
+// IssueUpdate is one issue's changes in a batch of updates.
+type IssueUpdate struct {
+	IssueID string
+	Input   IssueUpdateInput
+}
+
+// UpdateIssues sends the updates in batches, returning one error per update.
+func (c *client) UpdateIssues(ctx context.Context, updates []IssueUpdate) []error {
+	results := make([]struct {
+		Success graphql.Boolean `graphql:"success"`
+	}, len(updates))
+	ops := make([]BatchOp, len(updates))
+	for i, update := range updates {
+		ops[i] = BatchOp{
+			Field: "issueUpdate(id: $issueUpdateId, input: $input)",
+			Variables: map[string]any{
+				"issueUpdateId": graphql.String(update.IssueID),
+				"input":         update.Input,
+			},
+			Result: &results[i],
+		}
+	}
+	errs := c.MutateBatch(ctx, ops)
+	for i, err := range errs {
+		if err != nil {
+			errs[i] = fmt.Errorf("failed to update issue: %w", err)
+		} else if !results[i].Success {
+			errs[i] = errors.New("issue update was not successful")
+		}
+	}
+	return errs
 }
 
 func (c *client) UpdateStatusOnIssue(ctx context.Context, issueID string, status string) error {
@@ -365,7 +457,6 @@ func (c *client) UpdateStatusOnIssue(ctx context.Context, issueID string, status
 	if !mutation.IssueUpdate.Success {
 		return errors.New("Issue Status not updated\n")
 	}
-	fmt.Print("Successfully updated status\n")
 	return nil
 }
 
@@ -382,7 +473,7 @@ func (c *client) SearchLabel(ctx context.Context, labelName string) (string, err
 	}
 	err := c.gql.Query(ctx, &query, variables)
 	if err != nil {
-		return "", fmt.Errorf("Could not find label properly: %w\n", err)
+		return "", fmt.Errorf("Could not find label properly: %w", err)
 	}
 	if len(query.IssueLabels.Nodes) > 0 {
 		return string(query.IssueLabels.Nodes[0].ID), nil
@@ -417,52 +508,84 @@ func (c *client) CreateNewLabel(ctx context.Context, labelName string) (string,
 	return string(mutation.IssueLabelCreate.IssueLabel.ID), nil
 }
 
-func (c *client) AddLabeltoIssue(ctx context.Context, issueID string, labelName string) error {
-	label, _ := c.SearchLabel(ctx, labelName)
-	if label == "" {
-		var err error
-		label, err = c.CreateNewLabel(ctx, labelName)
-		if err != nil {
-			return err
+// LabelIDs looks up each label by name, creating the ones that do not exist.
+func (c *client) LabelIDs(ctx context.Context, labelNames []string) ([]string, error) {
+	ids := make([]string, 0, len(labelNames))
+	for _, name := range labelNames {
+		label, _ := c.SearchLabel(ctx, name)
+		if label == "" {
+			var err error
+			label, err = c.CreateNewLabel(ctx, name)
+			if err != nil {
+				return nil, err
+			}
 		}
+		ids = append(ids, label)
 	}
-	var mutation struct {
-		IssueAddLabel struct {
-			Success graphql.Boolean `graphql:"success"`
-		} `graphql:"issueAddLabel(id: $issueAddLabelId, labelId: $labelId)"`
-	}
-	variables := map[string]any{
-		"issueAddLabelId": graphql.String(issueID),
-		"labelId":         graphql.String(label),
+	return ids, nil
+}
+
+type LabelData struct {
+	ID   graphql.String `json:"id"`
+	Name graphql.String `json:"name"`
+}
+
+func (c *client) GetLabels(ctx context.Context) ([]LabelData, error) {
+	var query struct {
+		IssueLabels struct {
+			Nodes []LabelData
+		} `graphql:"issueLabels(first: 250)"`
 	}
-	err := c.gql.Mutate(ctx, &mutation, variables)
+	err := c.gql.Query(ctx, &query, nil)
 	if err != nil {
-		return fmt.Errorf("failed to add label to issue: %w\n", err)
+		return nil, fmt.Errorf("failed to fetch labels: %w", err)
 	}
-	fmt.Printf("successfully added '%s' to issue!\n", labelName)
-	return nil
+	return query.IssueLabels.Nodes, nil
 }
 
-func (c *client) RemoveLabelFromIssue(ctx context.Context, issueID string, labelName string) error {
-	label, _ := c.SearchLabel(ctx, labelName)
-	if label == "" {
-		return errors.New("A label with this name does not exist\n")
-	}
-	var mutation struct {
-		IssueRemoveLabel struct {
-			Success graphql.Boolean `graphql:"success"`
-		} `graphql:"issueRemoveLabel(id: $issueRemoveLabelId, labelId: $labelId)"`
+func (c *client) GetIssueLabels(ctx context.Context, issueID string) ([]LabelData, error) {
+	var query struct {
+		Issue struct {
+			Labels struct {
+				Nodes []LabelData
+			} `graphql:"labels(first: 250)"`
+		} `graphql:"issue(id: $issueId)"`
 	}
 	variables := map[string]any{
-		"issueRemoveLabelId": graphql.String(issueID),
-		"labelId":            graphql.String(label),
+		"issueId": graphql.String(issueID),
 	}
-	err := c.gql.Mutate(ctx, &mutation, variables)
+	err := c.gql.Query(ctx, &query, variables)
 	if err != nil {
-		return fmt.Errorf("failed to remove label: %w\n", err)
+		return nil, fmt.Errorf("failed to fetch issue labels: %w", err)
 	}
-	fmt.Printf("successfully removed '%s' from issue!\n", labelName)
-	return nil
+	return query.Issue.Labels.Nodes, nil
+}
+
+// GetIssueLabelSets fetches the labels of many issues in batches.
+func (c *client) GetIssueLabelSets(ctx context.Context, issueIDs []string) ([][]LabelData, []error) {
+	results := make([]struct {
+		Labels struct {
+			Nodes []LabelData
+		} `graphql:"labels(first: 250)"`
+	}, len(issueIDs))
+	ops := make([]BatchOp, len(issueIDs))
+	for i, issueID := range issueIDs {
+		ops[i] = BatchOp{
+			Field:     "issue(id: $issueId)",
+			Variables: map[string]any{"issueId": graphql.String(issueID)},
+			Result:    &results[i],
+		}
+	}
+	errs := c.QueryBatch(ctx, ops)
+	labels := make([][]LabelData, len(issueIDs))
+	for i, err := range errs {
+		if err != nil {
+			errs[i] = fmt.Errorf("failed to fetch issue labels: %w", err)
+			continue
+		}
+		labels[i] = results[i].Labels.Nodes
+	}
+	return labels, errs
 }
 
 func (c *client) ListLabelsOfIssue(ctx context.Context, issueID string) error {
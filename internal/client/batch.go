@@ -0,0 +1,222 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/shurcooL/graphql"
+)
+
+// BatchOp is one operation of a batch. Field is the operation's field as it
+// would be written on its own, e.g. "issue(id: $issueId)", with arguments
+// referring to Variables by name; names are made unique within the batch.
+// Result must point to a struct shaped like the field's selection and is
+// filled in when the operation succeeds.
+type BatchOp struct {
+	Field     string
+	Variables map[string]any
+	Result    any
+}
+
+const (
+	// maxBatchOps caps how many operations share one document.
+	maxBatchOps = 50
+	// maxBatchComplexity keeps a document well below the 10,000 points
+	// Linear allows per request, since the estimate is rough.
+	maxBatchComplexity = 5000
+)
+
+// QueryBatch runs independent queries as aliased fields of as few documents
+// as the complexity limits allow. It returns one error per operation, nil
+// for those that succeeded.
+func (c *client) QueryBatch(ctx context.Context, ops []BatchOp) []error {
+	return c.runBatch(ctx, false, ops)
+}
+
+// MutateBatch is QueryBatch for mutations. Linear runs the mutations of a
+// document one after another, and a failing one does not undo the rest.
+func (c *client) MutateBatch(ctx context.Context, ops []BatchOp) []error {
+	return c.runBatch(ctx, true, ops)
+}
+
+func (c *client) runBatch(ctx context.Context, mutation bool, ops []BatchOp) []error {
+	errs := make([]error, len(ops))
+	var chunk []int
+	cost := 0.0
+	for i, op := range ops {
+		if reflect.TypeOf(op.Result) == nil || reflect.TypeOf(op.Result).Kind() != reflect.Pointer {
+			errs[i] = fmt.Errorf("batch result for %s must be a pointer", op.Field)
+			continue
+		}
+		opCost := operationComplexity(op)
+		if len(chunk) > 0 && (len(chunk) == maxBatchOps || cost+opCost > maxBatchComplexity) {
+			c.sendBatch(ctx, mutation, ops, chunk, errs)
+			chunk, cost = nil, 0
+		}
+		chunk = append(chunk, i)
+		cost += opCost
+	}
+	if len(chunk) > 0 {
+		c.sendBatch(ctx, mutation, ops, chunk, errs)
+	}
+	return errs
+}
+
+var variableRef = regexp.MustCompile(`\$(\w+)`)
+
+// sendBatch sends the operations at the chunk's indexes as one document,
+// aliased op0, op1, ..., and stores each one's error in errs. A document
+// Linear rejects as too complex is split in half and retried.
+func (c *client) sendBatch(ctx context.Context, mutation bool, ops []BatchOp, chunk []int, errs []error) {
+	fields := make([]reflect.StructField, 0, len(chunk))
+	variables := make(map[string]any)
+	for n, i := range chunk {
+		op := ops[i]
+		suffix := fmt.Sprintf("_%d", n)
+		field := variableRef.ReplaceAllString(op.Field, "$$${1}"+suffix)
+		for name, value := range op.Variables {
+			variables[name+suffix] = value
+		}
+		fields = append(fields, reflect.StructField{
+			Name: fmt.Sprintf("Op%d", n),
+			Type: reflect.TypeOf(op.Result),
+			Tag:  reflect.StructTag("graphql:" + strconv.Quote(fmt.Sprintf("op%d: %s", n, field))),
+		})
+	}
+	doc := reflect.New(reflect.StructOf(fields))
+
+	// shurcooL/graphql drops the path of each error, so the response is
+	// read again to find the operation an error belongs to.
+	capture := &captureTransport{base: c.httpClient.Transport}
+	if capture.base == nil {
+		capture.base = http.DefaultTransport
+	}
+	httpClient := *c.httpClient
+	httpClient.Transport = capture
+	gql := graphql.NewClient(c.apiURL, &httpClient)
+	var err error
+	if mutation {
+		err = gql.Mutate(ctx, doc.Interface(), variables)
+	} else {
+		err = gql.Query(ctx, doc.Interface(), variables)
+	}
+
+	failed, general := batchErrors(err, capture.body)
+	if general != nil && len(chunk) > 1 && strings.Contains(strings.ToLower(general.Error()), "complex") {
+		half := len(chunk) / 2
+		c.sendBatch(ctx, mutation, ops, chunk[:half], errs)
+		c.sendBatch(ctx, mutation, ops, chunk[half:], errs)
+		return
+	}
+	for n, i := range chunk {
+		result := doc.Elem().Field(n)
+		switch {
+		case failed == nil && general != nil:
+			errs[i] = general
+		case failed[n] != nil:
+			errs[i] = failed[n]
+		case result.IsNil() && general != nil:
+			errs[i] = general
+		case result.IsNil():
+			errs[i] = errors.New("no result returned")
+		default:
+			reflect.ValueOf(ops[i].Result).Elem().Set(result.Elem())
+		}
+	}
+}
+
+// batchErrors maps the GraphQL errors of a response to the position of the
+// operation in their path; errors without a path are returned as general.
+// failed is nil when the request failed without GraphQL errors, e.g. on a
+// network error, in which case general applies to every operation.
+func batchErrors(err error, body []byte) (failed map[int]error, general error) {
+	if err == nil {
+		return map[int]error{}, nil
+	}
+	var response struct {
+		Errors []struct {
+			Message string
+			Path    []any
+		}
+	}
+	if json.Unmarshal(body, &response) != nil || len(response.Errors) == 0 {
+		return nil, err
+	}
+	failed = make(map[int]error)
+	for _, e := range response.Errors {
+		if len(e.Path) > 0 {
+			alias, _ := e.Path[0].(string)
+			if n, err := strconv.Atoi(strings.TrimPrefix(alias, "op")); err == nil && failed[n] == nil {
+				failed[n] = errors.New(e.Message)
+				continue
+			}
+		}
+		if general == nil {
+			general = errors.New(e.Message)
+		}
+	}
+	return failed, general
+}
+
+type captureTransport struct {
+	base http.RoundTripper
+	body []byte
+}
+
+func (t *captureTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	t.body, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(t.body))
+	return resp, nil
+}
+
+var (
+	firstArg        = regexp.MustCompile(`first:\s*(\d+)`)
+	unmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+)
+
+// operationComplexity estimates what Linear charges for op: a point per
+// object and a tenth per scalar, with connections multiplied by the number
+// of nodes asked for.
+func operationComplexity(op BatchOp) float64 {
+	return complexity(reflect.TypeOf(op.Result)) * firstMultiplier(op.Field)
+}
+
+func complexity(t reflect.Type) float64 {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || reflect.PointerTo(t).Implements(unmarshalerType) {
+		return 0.1
+	}
+	cost := 1.0
+	for i := range t.NumField() {
+		field := t.Field(i)
+		cost += complexity(field.Type) * firstMultiplier(field.Tag.Get("graphql"))
+	}
+	return cost
+}
+
+func firstMultiplier(field string) float64 {
+	if match := firstArg.FindStringSubmatch(field); match != nil {
+		n, _ := strconv.Atoi(match[1])
+		return float64(max(n, 1))
+	}
+	return 1
+}
@@ -0,0 +1,144 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/shurcooL/graphql"
+)
+
+type m = map[string]any
+
+var aliasedIssue = regexp.MustCompile(`op(\d+):\s*issue\(id: \$(\w+)\)`)
+
+// batchServer answers aliased issue lookups and records how many
+// operations each document held. With rejectOnce the first document of more
+// than one operation is rejected as too complex. The issue "bad" fails on
+// its own.
+func batchServer(t *testing.T, rejectOnce bool) (*httptest.Server, *[]int) {
+	var sizes []int
+	rejected := !rejectOnce
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req graphqlRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Error(err)
+			return
+		}
+		matches := aliasedIssue.FindAllStringSubmatch(req.Query, -1)
+		sizes = append(sizes, len(matches))
+		if len(matches) > 1 && !rejected {
+			rejected = true
+			json.NewEncoder(w).Encode(m{"errors": []any{m{"message": "Query too complex, complexity: 12000"}}})
+			return
+		}
+
+		data := m{}
+		var errs []any
+		for _, match := range matches {
+			alias := "op" + match[1]
+			id, _ := req.Variables[match[2]].(string)
+			if id == "bad" {
+				data[alias] = nil
+				errs = append(errs, m{"message": "Entity not found", "path": []any{alias}})
+				continue
+			}
+			data[alias] = m{"title": "Issue " + id}
+		}
+		response := m{"data": data}
+		if errs != nil {
+			response["errors"] = errs
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	t.Cleanup(server.Close)
+	return server, &sizes
+}
+
+type batchTitle struct {
+	Title graphql.String
+}
+
+func TestQueryBatch(t *testing.T) {
+	server, sizes := batchServer(t, true)
+	c := NewClientWithHTTPClient(server.Client(), server.URL).(*client)
+
+	ids := []string{"a", "b", "bad", "c", "d"}
+	results := make([]batchTitle, len(ids))
+	ops := make([]BatchOp, len(ids))
+	for i, id := range ids {
+		ops[i] = BatchOp{
+			Field:     "issue(id: $issueId)",
+			Variables: map[string]any{"issueId": graphql.String(id)},
+			Result:    &results[i],
+		}
+	}
+	errs := c.QueryBatch(context.Background(), ops)
+
+	for i, id := range ids {
+		if id == "bad" {
+			if errs[i] == nil || errs[i].Error() != "Entity not found" {
+				t.Errorf("op %d (%s) error = %v, want Entity not found", i, id, errs[i])
+			}
+			continue
+		}
+		if errs[i] != nil {
+			t.Errorf("op %d (%s) error = %v", i, id, errs[i])
+			continue
+		}
+		if want := "Issue " + id; string(results[i].Title) != want {
+			t.Errorf("op %d title = %q, want %q", i, results[i].Title, want)
+		}
+	}
+	// The rejected document of five is retried as two and three.
+	if got := fmt.Sprint(*sizes); got != "[5 2 3]" {
+		t.Errorf("document sizes = %s, want [5 2 3]", got)
+	}
+}
+
+func TestQueryBatchGeneralError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(m{"errors": []any{m{"message": "Authentication required"}}})
+	}))
+	defer server.Close()
+	c := NewClientWithHTTPClient(server.Client(), server.URL).(*client)
+
+	results := make([]batchTitle, 2)
+	errs := c.QueryBatch(context.Background(), []BatchOp{
+		{Field: "issue(id: $issueId)", Variables: map[string]any{"issueId": graphql.String("a")}, Result: &results[0]},
+		{Field: "issue(id: $issueId)", Variables: map[string]any{"issueId": graphql.String("b")}, Result: &results[1]},
+	})
+	for i, err := range errs {
+		if err == nil || !strings.Contains(err.Error(), "Authentication required") {
+			t.Errorf("op %d error = %v, want the general error", i, err)
+		}
+	}
+}
+
+func TestQueryBatchChunksByCount(t *testing.T) {
+	server, sizes := batchServer(t, false)
+	c := NewClientWithHTTPClient(server.Client(), server.URL).(*client)
+
+	results := make([]batchTitle, 120)
+	ops := make([]BatchOp, len(results))
+	for i := range ops {
+		ops[i] = BatchOp{
+			Field:     "issue(id: $issueId)",
+			Variables: map[string]any{"issueId": graphql.String(fmt.Sprint(i))},
+			Result:    &results[i],
+		}
+	}
+	for i, err := range c.QueryBatch(context.Background(), ops) {
+		if err != nil || string(results[i].Title) != fmt.Sprintf("Issue %d", i) {
+			t.Fatalf("op %d = %q, %v", i, results[i].Title, err)
+		}
+	}
+	if got := fmt.Sprint(*sizes); got != "[50 50 20]" {
+		t.Errorf("document sizes = %s, want [50 50 20]", got)
+	}
+}
@@ -0,0 +1,193 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shurcooL/graphql"
+)
+
+type CommentData struct {
+	ID         graphql.String `json:"id"`
+	Body       graphql.String `json:"body"`
+	URL        graphql.String `json:"url"`
+	CreatedAt  time.Time      `json:"createdAt"`
+	EditedAt   *time.Time     `json:"editedAt"`
+	ResolvedAt *time.Time     `json:"resolvedAt"`
+	User       *UserRef       `json:"user"`
+	Parent     *struct {
+		ID graphql.String `json:"id"`
+	} `json:"parent"`
+}
+
+// ListComments returns every comment on an issue, replies included, oldest
+// first.
+func (c *client) ListComments(ctx context.Context, issueID string) ([]CommentData, error) {
+	var comments []CommentData
+	var after *graphql.String
+	for {
+		var query struct {
+			Issue struct {
+				Comments struct {
+					Nodes    []CommentData
+					PageInfo struct {
+						HasNextPage graphql.Boolean
+						EndCursor   graphql.String
+					}
+				} `graphql:"comments(first: 100, after: $after, orderBy: createdAt)"`
+			} `graphql:"issue(id: $issueId)"`
+		}
+
+		variables := map[string]any{
+			"issueId": graphql.String(issueID),
+			"after":   after,
+		}
+
+		err := c.gql.Query(ctx, &query, variables)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list comments: %w", err)
+		}
+
+		page := query.Issue.Comments
+		comments = append(comments, page.Nodes...)
+		if !page.PageInfo.HasNextPage {
+			return comments, nil
+		}
+		cursor := page.PageInfo.EndCursor
+		after = &cursor
+	}
+}
+
+// CommentCreateInput mirrors Linear's input type of the same name. ParentID
+// makes the comment a reply in an existing thread.
+type CommentCreateInput struct {
+	IssueID  graphql.String `json:"issueId"`
+	Body     graphql.String `json:"body"`
+	ParentID graphql.String `json:"parentId,omitempty"`
+}
+
+func (c *client) CreateComment(ctx context.Context, input CommentCreateInput) (*CommentData, error) {
+	if input.Body == "" {
+		return nil, errors.New("comment body is required")
+	}
+
+	var mutation struct {
+		CommentCreate struct {
+			Success graphql.Boolean
+			Comment CommentData
+		} `graphql:"commentCreate(input: $input)"`
+	}
+
+	variables := map[string]any{
+		"input": input,
+	}
+
+	err := c.gql.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create comment: %w", err)
+	}
+	if !mutation.CommentCreate.Success {
+		return nil, errors.New("comment creation was not successful")
+	}
+	return &mutation.CommentCreate.Comment, nil
+}
+
+func (c *client) GetComment(ctx context.Context, commentID string) (*CommentData, error) {
+	var query struct {
+		Comment CommentData `graphql:"comment(id: $commentId)"`
+	}
+
+	variables := map[string]any{
+		"commentId": graphql.String(commentID),
+	}
+
+	err := c.gql.Query(ctx, &query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch comment: %w", err)
+	}
+	if query.Comment.ID == "" {
+		return nil, fmt.Errorf("comment %s not found", commentID)
+	}
+	return &query.Comment, nil
+}
+
+func (c *client) UpdateComment(ctx context.Context, commentID string, body string) error {
+	var mutation struct {
+		CommentUpdate struct {
+			Success graphql.Boolean
+		} `graphql:"commentUpdate(id: $id, input: $input)"`
+	}
+	type CommentUpdateInput struct {
+		Body graphql.String `json:"body"`
+	}
+	variables := map[string]any{
+		"id":    graphql.String(commentID),
+		"input": CommentUpdateInput{Body: graphql.String(body)},
+	}
+
+	err := c.gql.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		return fmt.Errorf("failed to update comment: %w", err)
+	}
+	if !mutation.CommentUpdate.Success {
+		return errors.New("comment update was not successful")
+	}
+	return nil
+}
+
+func (c *client) DeleteComment(ctx context.Context, commentID string) error {
+	var mutation struct {
+		CommentDelete struct {
+			Success graphql.Boolean
+		} `graphql:"commentDelete(id: $id)"`
+	}
+	variables := map[string]any{
+		"id": graphql.String(commentID),
+	}
+
+	err := c.gql.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+	if !mutation.CommentDelete.Success {
+		return errors.New("comment deletion was not successful")
+	}
+	return nil
+}
+
+// ResolveComment resolves the thread a comment starts, or reopens it when
+// resolved is false.
+func (c *client) ResolveComment(ctx context.Context, commentID string, resolved bool) error {
+	var resolve struct {
+		CommentResolve struct {
+			Success graphql.Boolean
+		} `graphql:"commentResolve(id: $id)"`
+	}
+	var unresolve struct {
+		CommentUnresolve struct {
+			Success graphql.Boolean
+		} `graphql:"commentUnresolve(id: $id)"`
+	}
+	variables := map[string]any{
+		"id": graphql.String(commentID),
+	}
+
+	var err error
+	var success graphql.Boolean
+	if resolved {
+		err = c.gql.Mutate(ctx, &resolve, variables)
+		success = resolve.CommentResolve.Success
+	} else {
+		err = c.gql.Mutate(ctx, &unresolve, variables)
+		success = unresolve.CommentUnresolve.Success
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update comment thread: %w", err)
+	}
+	if !success {
+		return errors.New("comment thread update was not successful")
+	}
+	return nil
+}
@@ -0,0 +1,409 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/shurcooL/graphql"
+)
+
+type UserRef struct {
+	ID    graphql.String `json:"id"`
+	Name  graphql.String `json:"name"`
+	Email graphql.String `json:"email"`
+}
+
+type IssueRef struct {
+	ID         graphql.String `json:"id"`
+	Identifier graphql.String `json:"identifier"`
+	Title      graphql.String `json:"title"`
+	State      struct {
+		Name graphql.String `json:"name"`
+		Type graphql.String `json:"type"`
+	} `json:"state"`
+}
+
+// IssueDetail is everything `issues view` shows about one issue. Nullable
+// objects are pointers so a missing project or cycle can be told apart
+// from an empty one. Connections hold their first page unless completed
+// with completeConnections.
+type IssueDetail struct {
+	ID            graphql.String  `json:"id"`
+	Identifier    graphql.String  `json:"identifier"`
+	URL           graphql.String  `json:"url"`
+	Title         graphql.String  `json:"title"`
+	Description   graphql.String  `json:"description"`
+	Priority      graphql.Float   `json:"priority"`
+	PriorityLabel graphql.String  `json:"priorityLabel"`
+	Estimate      *graphql.Float  `json:"estimate"`
+	DueDate       *graphql.String `json:"dueDate"`
+	CreatedAt     time.Time       `json:"createdAt"`
+	UpdatedAt     time.Time       `json:"updatedAt"`
+	StartedAt     *time.Time      `json:"startedAt"`
+	CompletedAt   *time.Time      `json:"completedAt"`
+	CanceledAt    *time.Time      `json:"canceledAt"`
+	ArchivedAt    *time.Time      `json:"archivedAt"`
+	State         struct {
+		Name graphql.String `json:"name"`
+		Type graphql.String `json:"type"`
+	} `json:"state"`
+	Team struct {
+		Key  graphql.String `json:"key"`
+		Name graphql.String `json:"name"`
+	} `json:"team"`
+	Assignee *UserRef `json:"assignee"`
+	Creator  *UserRef `json:"creator"`
+	Labels   struct {
+		Nodes []struct {
+			Name graphql.String `json:"name"`
+		} `json:"nodes"`
+		PageInfo PageInfo `json:"-"`
+	} `graphql:"labels(first: 50)" json:"labels"`
+	Project *struct {
+		Name graphql.String `json:"name"`
+	} `json:"project"`
+	Cycle *struct {
+		Number graphql.Float  `json:"number"`
+		Name   graphql.String `json:"name"`
+	} `json:"cycle"`
+	Parent   *IssueRef `json:"parent"`
+	Children struct {
+		Nodes    []IssueRef `json:"nodes"`
+		PageInfo PageInfo   `json:"-"`
+	} `graphql:"children(first: 100)" json:"children"`
+	Relations struct {
+		Nodes []struct {
+			Type         graphql.String `json:"type"`
+			RelatedIssue IssueRef       `json:"relatedIssue"`
+		} `json:"nodes"`
+		PageInfo PageInfo `json:"-"`
+	} `graphql:"relations(first: 100)" json:"relations"`
+	InverseRelations struct {
+		Nodes []struct {
+			Type  graphql.String `json:"type"`
+			Issue IssueRef       `json:"issue"`
+		} `json:"nodes"`
+		PageInfo PageInfo `json:"-"`
+	} `graphql:"inverseRelations(first: 100)" json:"inverseRelations"`
+	Attachments struct {
+		Nodes []struct {
+			ID        graphql.String `json:"id"`
+			Title     graphql.String `json:"title"`
+			URL       graphql.String `json:"url"`
+			CreatedAt time.Time      `json:"createdAt"`
+		} `json:"nodes"`
+		PageInfo PageInfo `json:"-"`
+	} `graphql:"attachments(first: 100)" json:"attachments"`
+	Comments struct {
+		Nodes []struct {
+			ID        graphql.String `json:"id"`
+			Body      graphql.String `json:"body"`
+			CreatedAt time.Time      `json:"createdAt"`
+			User      *UserRef       `json:"user"`
+		} `json:"nodes"`
+		PageInfo PageInfo `json:"-"`
+	} `graphql:"comments(first: 100)" json:"comments"`
+	History struct {
+		Nodes    []IssueHistoryData `json:"nodes"`
+		PageInfo PageInfo           `json:"-"`
+	} `graphql:"history(first: 50)" json:"history"`
+}
+
+type IssueHistoryData struct {
+	CreatedAt    time.Time       `json:"createdAt"`
+	Actor        *UserRef        `json:"actor"`
+	FromState    *NameRef        `json:"fromState"`
+	ToState      *NameRef        `json:"toState"`
+	FromAssignee *NameRef        `json:"fromAssignee"`
+	ToAssignee   *NameRef        `json:"toAssignee"`
+	FromPriority *graphql.Float  `json:"fromPriority"`
+	ToPriority   *graphql.Float  `json:"toPriority"`
+	FromTitle    *graphql.String `json:"fromTitle"`
+	ToTitle      *graphql.String `json:"toTitle"`
+}
+
+// PageInfo tells whether a connection has nodes past the ones fetched.
+type PageInfo struct {
+	HasNextPage graphql.Boolean `json:"-"`
+	EndCursor   graphql.String  `json:"-"`
+}
+
+type NameRef struct {
+	ID   graphql.String `json:"id"`
+	Name graphql.String `json:"name"`
+}
+
+func (c *client) GetIssue(ctx context.Context, issueID string) (*IssueDetail, error) {
+	var query struct {
+		Issue IssueDetail `graphql:"issue(id: $issueId)"`
+	}
+
+	variables := map[string]any{
+		"issueId": graphql.String(issueID),
+	}
+
+	err := c.gql.Query(ctx, &query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch issue: %w", err)
+	}
+	return &query.Issue, nil
+}
+
+// GetIssues fetches the full detail of many issues in batches. Unlike
+// GetIssue every connection is complete, not just its first page.
+func (c *client) GetIssues(ctx context.Context, issueIDs []string) ([]*IssueDetail, []error) {
+	issues := make([]*IssueDetail, len(issueIDs))
+	ops := make([]BatchOp, len(issueIDs))
+	for i, issueID := range issueIDs {
+		issues[i] = &IssueDetail{}
+		ops[i] = BatchOp{
+			Field:     "issue(id: $issueId)",
+			Variables: map[string]any{"issueId": graphql.String(issueID)},
+			Result:    issues[i],
+		}
+	}
+	errs := c.QueryBatch(ctx, ops)
+	for i, err := range errs {
+		if err == nil {
+			err = c.completeConnections(ctx, issues[i].ID, issues[i])
+		}
+		if err != nil {
+			issues[i], errs[i] = nil, fmt.Errorf("failed to fetch issue %s: %w", issueIDs[i], err)
+		}
+	}
+	return issues, errs
+}
+
+// connectionPageSize is how many nodes each follow-up page of a connection
+// asks for.
+const connectionPageSize = 100
+
+// completeConnections fetches the remaining pages of every connection of
+// issue that has more nodes than its first page held. issue points to a
+// struct queried from issue(id: issueID) whose paged connections carry a
+// PageInfo.
+func (c *client) completeConnections(ctx context.Context, issueID graphql.String, issue any) error {
+	v := reflect.ValueOf(issue).Elem()
+	for i := range v.NumField() {
+		conn := v.Field(i)
+		if conn.Kind() != reflect.Struct || !conn.FieldByName("PageInfo").IsValid() {
+			continue
+		}
+		field, _, _ := strings.Cut(v.Type().Field(i).Tag.Get("graphql"), "(")
+		for {
+			pageInfo := conn.FieldByName("PageInfo").Interface().(PageInfo)
+			if !pageInfo.HasNextPage {
+				break
+			}
+			// The query is built at runtime since the connection is only
+			// known by its field name and anonymous node type.
+			query := reflect.New(reflect.StructOf([]reflect.StructField{{
+				Name: "Issue",
+				Type: reflect.StructOf([]reflect.StructField{{
+					Name: "Connection",
+					Type: conn.Type(),
+					Tag:  reflect.StructTag(fmt.Sprintf(`graphql:"%s(first: %d, after: $after)"`, field, connectionPageSize)),
+				}}),
+				Tag: `graphql:"issue(id: $issueId)"`,
+			}}))
+			variables := map[string]any{
+				"issueId": issueID,
+				"after":   pageInfo.EndCursor,
+			}
+			if err := c.gql.Query(ctx, query.Interface(), variables); err != nil {
+				return fmt.Errorf("failed to fetch %s: %w", field, err)
+			}
+			page := query.Elem().Field(0).Field(0)
+			if page.FieldByName("Nodes").Len() == 0 {
+				return fmt.Errorf("failed to fetch %s: an empty page claims more follow", field)
+			}
+			nodes := conn.FieldByName("Nodes")
+			nodes.Set(reflect.AppendSlice(nodes, page.FieldByName("Nodes")))
+			conn.FieldByName("PageInfo").Set(page.FieldByName("PageInfo"))
+		}
+	}
+	return nil
+}
+
+// GetIssueRef fetches just enough of an issue to identify it. issueID may be
+// a UUID or a team-key identifier such as ENG-123.
+func (c *client) GetIssueRef(ctx context.Context, issueID string) (*IssueRef, error) {
+	var query struct {
+		Issue IssueRef `graphql:"issue(id: $issueId)"`
+	}
+
+	variables := map[string]any{
+		"issueId": graphql.String(issueID),
+	}
+
+	err := c.gql.Query(ctx, &query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find issue %s: %w", issueID, err)
+	}
+	return &query.Issue, nil
+}
+
+// IssueFilter is Linear's IssueFilter input, built by the filter package.
+// The type name is what the graphql package declares the variable as.
+type IssueFilter map[string]any
+
+// IssueSummary is the shape of each row in issue listings.
+type IssueSummary struct {
+	ID            graphql.String `json:"id"`
+	Identifier    graphql.String `json:"identifier"`
+	Title         graphql.String `json:"title"`
+	URL           graphql.String `json:"url"`
+	Priority      graphql.Float  `json:"priority"`
+	PriorityLabel graphql.String `json:"priorityLabel"`
+	Estimate      *graphql.Float `json:"estimate"`
+	CreatedAt     time.Time      `json:"createdAt"`
+	UpdatedAt     time.Time      `json:"updatedAt"`
+	State         struct {
+		Name graphql.String `json:"name"`
+		Type graphql.String `json:"type"`
+	} `json:"state"`
+	Team struct {
+		ID  graphql.String `json:"id"`
+		Key graphql.String `json:"key"`
+	} `json:"team"`
+	Assignee *UserRef `json:"assignee"`
+	Labels   struct {
+		Nodes []NameRef `json:"nodes"`
+	} `graphql:"labels(first: 20)" json:"labels"`
+	Project *NameRef `json:"project"`
+	Cycle   *struct {
+		ID     graphql.String `json:"id"`
+		Number graphql.Float  `json:"number"`
+		Name   graphql.String `json:"name"`
+	} `json:"cycle"`
+	Parent *struct {
+		ID         graphql.String `json:"id"`
+		Identifier graphql.String `json:"identifier"`
+	} `json:"parent"`
+	ArchivedAt *time.Time      `json:"archivedAt"`
+	Trashed    graphql.Boolean `json:"trashed"`
+}
+
+// GetIssueSummary fetches one issue in the shape used by listings. issueID
+// may be a UUID or an identifier such as ENG-123.
+func (c *client) GetIssueSummary(ctx context.Context, issueID string) (*IssueSummary, error) {
+	var query struct {
+		Issue IssueSummary `graphql:"issue(id: $issueId)"`
+	}
+
+	variables := map[string]any{
+		"issueId": graphql.String(issueID),
+	}
+
+	err := c.gql.Query(ctx, &query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find issue %s: %w", issueID, err)
+	}
+	if query.Issue.ID == "" {
+		return nil, fmt.Errorf("issue %s not found", issueID)
+	}
+	return &query.Issue, nil
+}
+
+// GetIssueSummaries fetches many issues in batches. An issue that cannot be
+// found has a nil summary and an error at the same index.
+func (c *client) GetIssueSummaries(ctx context.Context, issueIDs []string) ([]*IssueSummary, []error) {
+	issues := make([]*IssueSummary, len(issueIDs))
+	ops := make([]BatchOp, len(issueIDs))
+	for i, issueID := range issueIDs {
+		issues[i] = &IssueSummary{}
+		ops[i] = BatchOp{
+			Field:     "issue(id: $issueId)",
+			Variables: map[string]any{"issueId": graphql.String(issueID)},
+			Result:    issues[i],
+		}
+	}
+	errs := c.QueryBatch(ctx, ops)
+	for i, err := range errs {
+		if err != nil {
+			issues[i], errs[i] = nil, fmt.Errorf("failed to find issue %s: %w", issueIDs[i], err)
+		}
+	}
+	return issues, errs
+}
+
+// ListOptions controls how many issues a listing fetches and whether
+// archived ones are included.
+type ListOptions struct {
+	// Limit caps the number of issues; 0 fetches everything.
+	Limit           int
+	IncludeArchived bool
+}
+
+func (o ListOptions) pageSize(fetched int) int {
+	if o.Limit > 0 && o.Limit-fetched < 100 {
+		return o.Limit - fetched
+	}
+	return 100
+}
+
+// issuePage is one page of an issues connection.
+type issuePage struct {
+	Nodes    []IssueSummary
+	PageInfo PageInfo
+}
+
+// eachPage pages through an issues connection until it ends or the limit
+// is reached, calling fn with every page. fetch runs the query for one page
+// given the first, after and includeArchived variables.
+func (o ListOptions) eachPage(fetch func(variables map[string]any) (*issuePage, error), fn func(page []IssueSummary) error) error {
+	var after *graphql.String
+	fetched := 0
+	for {
+		page, err := fetch(map[string]any{
+			"first":           graphql.Int(o.pageSize(fetched)),
+			"after":           after,
+			"includeArchived": graphql.Boolean(o.IncludeArchived),
+		})
+		if err != nil {
+			return err
+		}
+
+		fetched += len(page.Nodes)
+		if err := fn(page.Nodes); err != nil {
+			return err
+		}
+		if !page.PageInfo.HasNextPage || (o.Limit > 0 && fetched >= o.Limit) {
+			return nil
+		}
+		cursor := page.PageInfo.EndCursor
+		after = &cursor
+	}
+}
+
+// ListIssues pages through issues matching filter until the limit is
+// reached.
+func (c *client) ListIssues(ctx context.Context, filter IssueFilter, opts ListOptions) ([]IssueSummary, error) {
+	var issues []IssueSummary
+	err := c.EachIssuePage(ctx, filter, opts, func(page []IssueSummary) error {
+		issues = append(issues, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return issues, nil
+}
+
+// EachIssuePage calls fn with every page of issues matching filter, so
+// callers can work through a whole team without holding it in memory.
+func (c *client) EachIssuePage(ctx context.Context, filter IssueFilter, opts ListOptions, fn func(page []IssueSummary) error) error {
+	return opts.eachPage(func(variables map[string]any) (*issuePage, error) {
+		var query struct {
+			Issues issuePage `graphql:"issues(filter: $filter, first: $first, after: $after, includeArchived: $includeArchived)"`
+		}
+		variables["filter"] = filter
+		if err := c.gql.Query(ctx, &query, variables); err != nil {
+			return nil, fmt.Errorf("failed to list issues: %w", err)
+		}
+		return &query.Issues, nil
+	}, fn)
+}
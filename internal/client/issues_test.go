@@ -0,0 +1,90 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type graphqlRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables"`
+}
+
+func historyNodes(from, to int) []map[string]any {
+	nodes := make([]map[string]any, 0, to-from)
+	for n := from; n < to; n++ {
+		nodes = append(nodes, map[string]any{
+			"createdAt": "2026-10-01T10:00:00Z",
+			"toTitle":   fmt.Sprintf("title %d", n),
+		})
+	}
+	return nodes
+}
+
+func TestGetIssuesPagesHistory(t *testing.T) {
+	var requests []graphqlRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req graphqlRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+		requests = append(requests, req)
+
+		var data map[string]any
+		switch {
+		case strings.Contains(req.Query, "op0:"):
+			empty := map[string]any{"nodes": []any{}, "pageInfo": map[string]any{"hasNextPage": false, "endCursor": ""}}
+			data = map[string]any{"op0": map[string]any{
+				"id":               "i1",
+				"identifier":       "ENG-1",
+				"createdAt":        "2026-10-01T10:00:00Z",
+				"updatedAt":        "2026-10-01T10:00:00Z",
+				"labels":           empty,
+				"children":         empty,
+				"relations":        empty,
+				"inverseRelations": empty,
+				"attachments":      empty,
+				"comments":         empty,
+				"history": map[string]any{
+					"nodes":    historyNodes(0, 50),
+					"pageInfo": map[string]any{"hasNextPage": true, "endCursor": "c50"},
+				},
+			}}
+		case strings.Contains(req.Query, "history(first: 100, after: $after)"):
+			if req.Variables["after"] != "c50" || req.Variables["issueId"] != "i1" {
+				t.Errorf("history page variables = %v", req.Variables)
+			}
+			data = map[string]any{"issue": map[string]any{"history": map[string]any{
+				"nodes":    historyNodes(50, 70),
+				"pageInfo": map[string]any{"hasNextPage": false, "endCursor": "c70"},
+			}}}
+		default:
+			t.Errorf("unexpected query %s", req.Query)
+		}
+		json.NewEncoder(w).Encode(map[string]any{"data": data})
+	}))
+	defer server.Close()
+
+	c := NewClientWithHTTPClient(server.Client(), server.URL)
+	issues, errs := c.GetIssues(context.Background(), []string{"i1"})
+	if errs[0] != nil {
+		t.Fatal(errs[0])
+	}
+	history := issues[0].History.Nodes
+	if len(history) != 70 {
+		t.Fatalf("got %d history entries, want 70", len(history))
+	}
+	for n, entry := range history {
+		if want := fmt.Sprintf("title %d", n); entry.ToTitle == nil || string(*entry.ToTitle) != want {
+			t.Fatalf("history[%d] = %v, want %s", n, entry.ToTitle, want)
+		}
+	}
+	if len(requests) != 2 {
+		t.Errorf("sent %d requests, want 2", len(requests))
+	}
+}
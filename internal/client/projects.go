@@ -0,0 +1,32 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shurcooL/graphql"
+)
+
+func (c *client) FindProjectByName(ctx context.Context, name string) (string, error) {
+	var query struct {
+		Projects struct {
+			Nodes []struct {
+				ID   graphql.String
+				Name graphql.String
+			}
+		} `graphql:"projects(filter: {name: {eqIgnoreCase: $name}})"`
+	}
+
+	variables := map[string]any{
+		"name": graphql.String(name),
+	}
+	err := c.gql.Query(ctx, &query, variables)
+	if err != nil {
+		return "", fmt.Errorf("Could not find Project by Name: %w", err)
+	}
+	projects := query.Projects.Nodes
+	if len(projects) != 1 {
+		return "", fmt.Errorf("Couldn't find one exact Project named %q", name)
+	}
+	return string(projects[0].ID), nil
+}
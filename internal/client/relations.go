@@ -0,0 +1,182 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/shurcooL/graphql"
+)
+
+// IssueRelationData is one relation as seen from the issue it belongs to;
+// Issue is the other end.
+type IssueRelationData struct {
+	ID    graphql.String `json:"id"`
+	Type  graphql.String `json:"type"`
+	Issue IssueRef       `json:"issue"`
+	// Inverse is set when the relation was created from the other issue,
+	// e.g. "blocks" then means the other issue blocks this one.
+	Inverse bool `json:"inverse"`
+}
+
+// GetIssueRelations returns the relations of an issue in both directions.
+func (c *client) GetIssueRelations(ctx context.Context, issueID string) ([]IssueRelationData, error) {
+	var query struct {
+		Issue struct {
+			Relations struct {
+				Nodes []struct {
+					ID           graphql.String
+					Type         graphql.String
+					RelatedIssue IssueRef
+				}
+			} `graphql:"relations(first: 100)"`
+			InverseRelations struct {
+				Nodes []struct {
+					ID    graphql.String
+					Type  graphql.String
+					Issue IssueRef
+				}
+			} `graphql:"inverseRelations(first: 100)"`
+		} `graphql:"issue(id: $issueId)"`
+	}
+
+	variables := map[string]any{
+		"issueId": graphql.String(issueID),
+	}
+
+	err := c.gql.Query(ctx, &query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch issue relations: %w", err)
+	}
+
+	var relations []IssueRelationData
+	for _, r := range query.Issue.Relations.Nodes {
+		relations = append(relations, IssueRelationData{ID: r.ID, Type: r.Type, Issue: r.RelatedIssue})
+	}
+	for _, r := range query.Issue.InverseRelations.Nodes {
+		relations = append(relations, IssueRelationData{ID: r.ID, Type: r.Type, Issue: r.Issue, Inverse: true})
+	}
+	return relations, nil
+}
+
+// CreateIssueRelation records that issueID relates to relatedIssueID.
+// relationType is one of Linear's relation types: blocks, duplicate or
+// related.
+func (c *client) CreateIssueRelation(ctx context.Context, issueID string, relatedIssueID string, relationType string) error {
+	var mutation struct {
+		IssueRelationCreate struct {
+			Success graphql.Boolean
+		} `graphql:"issueRelationCreate(input: $input)"`
+	}
+	type IssueRelationCreateInput struct {
+		IssueID        graphql.String `json:"issueId"`
+		RelatedIssueID graphql.String `json:"relatedIssueId"`
+		Type           graphql.String `json:"type"`
+	}
+	variables := map[string]any{
+		"input": IssueRelationCreateInput{
+			IssueID:        graphql.String(issueID),
+			RelatedIssueID: graphql.String(relatedIssueID),
+			Type:           graphql.String(relationType),
+		},
+	}
+
+	err := c.gql.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		return fmt.Errorf("failed to create issue relation: %w", err)
+	}
+	if !mutation.IssueRelationCreate.Success {
+		return errors.New("issue relation creation was not successful")
+	}
+	return nil
+}
+
+func (c *client) DeleteIssueRelation(ctx context.Context, relationID string) error {
+	var mutation struct {
+		IssueRelationDelete struct {
+			Success graphql.Boolean
+		} `graphql:"issueRelationDelete(id: $id)"`
+	}
+	variables := map[string]any{
+		"id": graphql.String(relationID),
+	}
+
+	err := c.gql.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		return fmt.Errorf("failed to delete issue relation: %w", err)
+	}
+	if !mutation.IssueRelationDelete.Success {
+		return errors.New("issue relation deletion was not successful")
+	}
+	return nil
+}
+
+// IssueBlockers is an issue with the issues it blocks and the issues
+// blocking it, the shape used to draw dependency graphs. Both connections
+// are completed past their first page.
+type IssueBlockers struct {
+	ID         graphql.String `json:"id"`
+	Identifier graphql.String `json:"identifier"`
+	Title      graphql.String `json:"title"`
+	State      struct {
+		Name graphql.String `json:"name"`
+		Type graphql.String `json:"type"`
+	} `json:"state"`
+	// The first pages are kept small so a page of 50 issues stays within
+	// Linear's query complexity limit.
+	Relations struct {
+		Nodes []struct {
+			Type         graphql.String `json:"type"`
+			RelatedIssue IssueRef       `json:"relatedIssue"`
+		} `json:"nodes"`
+		PageInfo PageInfo `json:"-"`
+	} `graphql:"relations(first: 25)" json:"relations"`
+	InverseRelations struct {
+		Nodes []struct {
+			Type  graphql.String `json:"type"`
+			Issue IssueRef       `json:"issue"`
+		} `json:"nodes"`
+		PageInfo PageInfo `json:"-"`
+	} `graphql:"inverseRelations(first: 25)" json:"inverseRelations"`
+}
+
+// ListIssueBlockers pages through the issues matching filter along with
+// their relations in both directions.
+func (c *client) ListIssueBlockers(ctx context.Context, filter IssueFilter) ([]IssueBlockers, error) {
+	var issues []IssueBlockers
+	var after *graphql.String
+	for {
+		var query struct {
+			Issues struct {
+				Nodes    []IssueBlockers
+				PageInfo struct {
+					HasNextPage graphql.Boolean
+					EndCursor   graphql.String
+				}
+			} `graphql:"issues(filter: $filter, first: 50, after: $after)"`
+		}
+
+		variables := map[string]any{
+			"filter": filter,
+			"after":  after,
+		}
+
+		err := c.gql.Query(ctx, &query, variables)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list issue relations: %w", err)
+		}
+
+		for i := range query.Issues.Nodes {
+			issue := &query.Issues.Nodes[i]
+			if err := c.completeConnections(ctx, issue.ID, issue); err != nil {
+				return nil, fmt.Errorf("failed to list relations of %s: %w", issue.Identifier, err)
+			}
+		}
+		issues = append(issues, query.Issues.Nodes...)
+		if !query.Issues.PageInfo.HasNextPage {
+			return issues, nil
+		}
+		cursor := query.Issues.PageInfo.EndCursor
+		after = &cursor
+	}
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestListIssueBlockersPagesRelations(t *testing.T) {
+	blocker := func(identifier string) map[string]any {
+		return map[string]any{"type": "blocks", "issue": map[string]any{"identifier": identifier}}
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req graphqlRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Error(err)
+			return
+		}
+		var data map[string]any
+		switch {
+		case strings.Contains(req.Query, "issues(filter: $filter"):
+			data = map[string]any{"issues": map[string]any{
+				"nodes": []any{map[string]any{
+					"id":         "i1",
+					"identifier": "ENG-1",
+					"relations": map[string]any{
+						"nodes":    []any{},
+						"pageInfo": map[string]any{"hasNextPage": false},
+					},
+					"inverseRelations": map[string]any{
+						"nodes":    []any{blocker("OPS-1")},
+						"pageInfo": map[string]any{"hasNextPage": true, "endCursor": "c1"},
+					},
+				}},
+				"pageInfo": map[string]any{"hasNextPage": false},
+			}}
+		case strings.Contains(req.Query, "inverseRelations(first: 100, after: $after)"):
+			if req.Variables["after"] != "c1" || req.Variables["issueId"] != "i1" {
+				t.Errorf("inverseRelations page variables = %v", req.Variables)
+			}
+			data = map[string]any{"issue": map[string]any{"inverseRelations": map[string]any{
+				"nodes":    []any{blocker("OPS-2")},
+				"pageInfo": map[string]any{"hasNextPage": false, "endCursor": "c2"},
+			}}}
+		default:
+			t.Errorf("unexpected query %s", req.Query)
+		}
+		json.NewEncoder(w).Encode(map[string]any{"data": data})
+	}))
+	defer server.Close()
+
+	c := NewClientWithHTTPClient(server.Client(), server.URL)
+	issues, err := c.ListIssueBlockers(context.Background(), IssueFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 1 {
+		t.Fatalf("got %d issues, want 1", len(issues))
+	}
+	var blockers []string
+	for _, relation := range issues[0].InverseRelations.Nodes {
+		blockers = append(blockers, string(relation.Issue.Identifier))
+	}
+	if strings.Join(blockers, " ") != "OPS-1 OPS-2" {
+		t.Errorf("blockers = %v, want OPS-1 OPS-2", blockers)
+	}
+}
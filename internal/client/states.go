@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/shurcooL/graphql"
+)
+
+type WorkflowStateData struct {
+	ID       graphql.String
+	Name     graphql.String
+	Type     graphql.String
+	Color    graphql.String
+	Position graphql.Float
+}
+
+// stateTypeOrder is the order Linear shows state types in.
+var stateTypeOrder = map[string]int{
+	"triage":    0,
+	"backlog":   1,
+	"unstarted": 2,
+	"started":   3,
+	"completed": 4,
+	"canceled":  5,
+}
+
+// GetWorkflowStates returns a team's states in workflow order.
+func (c *client) GetWorkflowStates(ctx context.Context, teamID string) ([]WorkflowStateData, error) {
+	var query struct {
+		WorkflowStates struct {
+			Nodes []WorkflowStateData
+		} `graphql:"workflowStates(first: 250, filter: {team: {id: {eq: $teamId}}})"`
+	}
+
+	variables := map[string]any{
+		"teamId": graphql.ID(teamID),
+	}
+
+	err := c.gql.Query(ctx, &query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch workflow states: %w", err)
+	}
+
+	states := query.WorkflowStates.Nodes
+	sort.SliceStable(states, func(i, j int) bool {
+		ti, tj := stateTypeOrder[string(states[i].Type)], stateTypeOrder[string(states[j].Type)]
+		if ti != tj {
+			return ti < tj
+		}
+		return states[i].Position < states[j].Position
+	})
+	return states, nil
+}
+
+func (c *client) GetIssueTeamID(ctx context.Context, issueID string) (string, error) {
+	var query struct {
+		Issue struct {
+			Team struct {
+				ID graphql.String
+			}
+		} `graphql:"issue(id: $issueId)"`
+	}
+
+	variables := map[string]any{
+		"issueId": graphql.String(issueID),
+	}
+
+	err := c.gql.Query(ctx, &query, variables)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch issue team: %w", err)
+	}
+	return string(query.Issue.Team.ID), nil
+}
+
+// IssueStateData is the slice of an issue needed to move it between states.
+type IssueStateData struct {
+	ID         graphql.String
+	Identifier graphql.String
+	Team       struct {
+		ID  graphql.String
+		Key graphql.String
+	}
+	State WorkflowStateData
+}
+
+func (c *client) GetIssueState(ctx context.Context, issueID string) (*IssueStateData, error) {
+	var query struct {
+		Issue IssueStateData `graphql:"issue(id: $issueId)"`
+	}
+
+	variables := map[string]any{
+		"issueId": graphql.String(issueID),
+	}
+
+	err := c.gql.Query(ctx, &query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch issue state: %w", err)
+	}
+	return &query.Issue, nil
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shurcooL/graphql"
+)
+
+// TeamEstimation describes which estimates a team accepts.
+type TeamEstimation struct {
+	// Type is notUsed, exponential, fibonacci, linear or tShirt.
+	Type      graphql.String  `graphql:"issueEstimationType"`
+	AllowZero graphql.Boolean `graphql:"issueEstimationAllowZero"`
+	Extended  graphql.Boolean `graphql:"issueEstimationExtended"`
+}
+
+func (c *client) GetTeamEstimation(ctx context.Context, teamID string) (*TeamEstimation, error) {
+	var query struct {
+		Team TeamEstimation `graphql:"team(id: $teamId)"`
+	}
+
+	variables := map[string]any{
+		"teamId": graphql.String(teamID),
+	}
+
+	err := c.gql.Query(ctx, &query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch team estimation settings: %w", err)
+	}
+	return &query.Team, nil
+}
+
+type CycleData struct {
+	ID     graphql.String `json:"id"`
+	Number graphql.Float  `json:"number"`
+	Name   graphql.String `json:"name"`
+}
+
+// CycleFilter is Linear's CycleFilter input.
+type CycleFilter map[string]any
+
+// FindCycle returns the first of a team's cycles matching filter, or nil.
+func (c *client) FindCycle(ctx context.Context, teamID string, filter CycleFilter) (*CycleData, error) {
+	var query struct {
+		Team struct {
+			Cycles struct {
+				Nodes []CycleData
+			} `graphql:"cycles(filter: $filter, first: 1)"`
+		} `graphql:"team(id: $teamId)"`
+	}
+
+	variables := map[string]any{
+		"teamId": graphql.String(teamID),
+		"filter": filter,
+	}
+
+	err := c.gql.Query(ctx, &query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find cycle: %w", err)
+	}
+	if len(query.Team.Cycles.Nodes) == 0 {
+		return nil, nil
+	}
+	return &query.Team.Cycles.Nodes[0], nil
+}
@@ -0,0 +1,35 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shurcooL/graphql"
+)
+
+// TemplateData is a template stored in the Linear workspace. Type is
+// "issue" for issue templates; Team is nil for workspace-wide ones.
+type TemplateData struct {
+	ID          graphql.String `json:"id"`
+	Name        graphql.String `json:"name"`
+	Description graphql.String `json:"description"`
+	Type        graphql.String `json:"type"`
+	Team        *struct {
+		ID   graphql.String `json:"id"`
+		Key  graphql.String `json:"key"`
+		Name graphql.String `json:"name"`
+	} `json:"team"`
+}
+
+func (c *client) ListTemplates(ctx context.Context) ([]TemplateData, error) {
+	var query struct {
+		Templates []TemplateData `graphql:"templates"`
+	}
+
+	err := c.gql.Query(ctx, &query, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch templates: %w", err)
+	}
+
+	return query.Templates, nil
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shurcooL/graphql"
+)
+
+type UserData struct {
+	ID          graphql.String
+	Name        graphql.String
+	DisplayName graphql.String
+	Email       graphql.String
+	Active      graphql.Boolean
+}
+
+func (c *client) Viewer(ctx context.Context) (*UserData, error) {
+	var query struct {
+		Viewer UserData
+	}
+
+	err := c.gql.Query(ctx, &query, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch viewer: %w", err)
+	}
+
+	return &query.Viewer, nil
+}
+
+// ListUsers returns the active members of the workspace.
+func (c *client) ListUsers(ctx context.Context) ([]UserData, error) {
+	var query struct {
+		Users struct {
+			Nodes []UserData
+		} `graphql:"users(first: 250, filter: {active: {eq: true}})"`
+	}
+
+	err := c.gql.Query(ctx, &query, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch users: %w", err)
+	}
+
+	return query.Users.Nodes, nil
+}
+
+// SearchUsers finds active users whose name, display name or email contains
+// term, ignoring case.
+func (c *client) SearchUsers(ctx context.Context, term string) ([]UserData, error) {
+	var query struct {
+		Users struct {
+			Nodes []UserData
+		} `graphql:"users(first: 50, filter: {active: {eq: true}, or: [{name: {containsIgnoreCase: $term}}, {displayName: {containsIgnoreCase: $term}}, {email: {containsIgnoreCase: $term}}]})"`
+	}
+
+	variables := map[string]any{
+		"term": graphql.String(term),
+	}
+
+	err := c.gql.Query(ctx, &query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search users: %w", err)
+	}
+
+	return query.Users.Nodes, nil
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shurcooL/graphql"
+)
+
+type CustomViewData struct {
+	ID          graphql.String  `json:"id"`
+	Name        graphql.String  `json:"name"`
+	Description graphql.String  `json:"description"`
+	Shared      graphql.Boolean `json:"shared"`
+	Team        *struct {
+		Key graphql.String `json:"key"`
+	} `json:"team"`
+	Owner *NameRef `json:"owner"`
+}
+
+func (c *client) ListCustomViews(ctx context.Context) ([]CustomViewData, error) {
+	var query struct {
+		CustomViews struct {
+			Nodes []CustomViewData
+		} `graphql:"customViews(first: 250)"`
+	}
+
+	err := c.gql.Query(ctx, &query, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch custom views: %w", err)
+	}
+
+	return query.CustomViews.Nodes, nil
+}
+
+// ListCustomViewIssues runs a Linear custom view, so its filter and sort
+// are applied by the server. filter narrows the view further.
+func (c *client) ListCustomViewIssues(ctx context.Context, viewID string, filter IssueFilter, opts ListOptions) ([]IssueSummary, error) {
+	var issues []IssueSummary
+	err := opts.eachPage(func(variables map[string]any) (*issuePage, error) {
+		var query struct {
+			CustomView struct {
+				Issues issuePage `graphql:"issues(filter: $filter, first: $first, after: $after, includeArchived: $includeArchived)"`
+			} `graphql:"customView(id: $viewId)"`
+		}
+		variables["viewId"] = graphql.String(viewID)
+		variables["filter"] = filter
+		if err := c.gql.Query(ctx, &query, variables); err != nil {
+			return nil, fmt.Errorf("failed to list custom view issues: %w", err)
+		}
+		return &query.CustomView.Issues, nil
+	}, func(page []IssueSummary) error {
+		issues = append(issues, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return issues, nil
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestListCustomViewIssuesPagesToLimit(t *testing.T) {
+	var firsts []any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req graphqlRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Error(err)
+			return
+		}
+		if !strings.Contains(req.Query, "customView(id: $viewId)") || req.Variables["viewId"] != "v1" {
+			t.Errorf("unexpected request %s %v", req.Query, req.Variables)
+		}
+		if req.Variables["includeArchived"] != true {
+			t.Errorf("includeArchived = %v, want true", req.Variables["includeArchived"])
+		}
+		firsts = append(firsts, req.Variables["first"])
+		first := int(req.Variables["first"].(float64))
+		nodes := make([]map[string]any, first)
+		for i := range nodes {
+			nodes[i] = map[string]any{"identifier": fmt.Sprintf("ENG-%d", len(firsts)*1000+i), "createdAt": "2026-10-01T10:00:00Z", "updatedAt": "2026-10-01T10:00:00Z"}
+		}
+		json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"customView": map[string]any{"issues": map[string]any{
+			"nodes":    nodes,
+			"pageInfo": map[string]any{"hasNextPage": true, "endCursor": fmt.Sprint(len(firsts))},
+		}}}})
+	}))
+	defer server.Close()
+
+	c := NewClientWithHTTPClient(server.Client(), server.URL)
+	issues, err := c.ListCustomViewIssues(context.Background(), "v1", IssueFilter{}, ListOptions{Limit: 150, IncludeArchived: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 150 {
+		t.Errorf("got %d issues, want 150", len(issues))
+	}
+	if got := fmt.Sprint(firsts); got != "[100 50]" {
+		t.Errorf("page sizes = %s, want [100 50]", got)
+	}
+}
@@ -0,0 +1,168 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"time"
+
+	"github.com/junipery17/lineartui/internal/client"
+	"github.com/spf13/cobra"
+)
+
+// trashRetention is how long Linear keeps deleted issues before purging
+// them for good.
+const trashRetention = 30 * 24 * time.Hour
+
+var issuesArchiveCmd = &cobra.Command{
+	Use:   "archive <issue>...",
+	Short: "Archive issues",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		issues, err := resolveIssues(ctx, args)
+		if err != nil {
+			return err
+		}
+		return forEachIssue(issues, "archived", func(issue *client.IssueRef) error {
+			return linearClient.ArchiveIssue(ctx, string(issue.ID))
+		})
+	},
+}
+
+var issuesUnarchiveCmd = &cobra.Command{
+	Use:   "unarchive <issue>...",
+	Short: "Unarchive issues",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		issues, err := resolveIssues(ctx, args)
+		if err != nil {
+			return err
+		}
+		return forEachIssue(issues, "unarchived", func(issue *client.IssueRef) error {
+			return linearClient.UnarchiveIssue(ctx, string(issue.ID))
+		})
+	},
+}
+
+var issuesRestoreCmd = &cobra.Command{
+	Use:   "restore <issue>...",
+	Short: "Restore deleted issues from the trash",
+	Long: `Restore deleted issues from the trash. Linear purges deleted issues
+after 30 days, after which they cannot be restored.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		issues, err := resolveIssues(ctx, args)
+		if err != nil {
+			return err
+		}
+		return forEachIssue(issues, "restored", func(issue *client.IssueRef) error {
+			return linearClient.UnarchiveIssue(ctx, string(issue.ID))
+		})
+	},
+}
+
+var issuesTrashCmd = &cobra.Command{
+	Use:   "trash",
+	Short: "Inspect deleted issues",
+}
+
+var issuesTrashListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List deleted issues that can still be restored",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		// Deleting an issue updates it, so only issues touched within the
+		// retention period can be in the trash.
+		since := time.Now().Add(-trashRetention).UTC().Format(time.RFC3339)
+		issueFilter := client.IssueFilter{"updatedAt": map[string]any{"gte": since}}
+		if team, _ := cmd.Flags().GetString("team"); team != "" || cfg.Defaults.Team != "" || cfg.Linear.TeamID != "" {
+			teamID, err := resolveTeamID(ctx, team)
+			if err != nil {
+				return err
+			}
+			issueFilter = client.IssueFilter{"and": []any{
+				map[string]any{"team": map[string]any{"id": map[string]any{"eq": teamID}}},
+				map[string]any(issueFilter),
+			}}
+		}
+
+		issues, err := linearClient.ListIssues(ctx, issueFilter, client.ListOptions{IncludeArchived: true})
+		if err != nil {
+			return err
+		}
+		var trashed []client.IssueSummary
+		for _, issue := range issues {
+			if issue.Trashed && issue.ArchivedAt != nil {
+				trashed = append(trashed, issue)
+			}
+		}
+
+		switch outputFormat {
+		case outputJSON, outputCSV:
+			return printIssueList(trashed, listOptions{})
+		}
+		if len(trashed) == 0 {
+			fmt.Println("The trash is empty.")
+			return nil
+		}
+		for _, issue := range trashed {
+			left := time.Until(issue.ArchivedAt.Add(trashRetention))
+			days := int(math.Ceil(left.Hours() / 24))
+			fmt.Printf("%-10s %-50s deleted %s, purged in %d days\n", issue.Identifier, truncate(string(issue.Title), 50), relativeTime(*issue.ArchivedAt), max(days, 0))
+		}
+		return nil
+	},
+}
+
+// resolveIssues resolves every ref before anything is changed, so a typo
+// in the last argument does not leave the first ones already modified.
+func resolveIssues(ctx context.Context, refs []string) ([]*client.IssueRef, error) {
+	issues := make([]*client.IssueRef, 0, len(refs))
+	for _, ref := range refs {
+		issue, err := resolveIssue(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, issue)
+	}
+	return issues, nil
+}
+
+// forEachIssue applies fn to every issue, reporting failures without
+// stopping at the first one.
+func forEachIssue(issues []*client.IssueRef, done string, fn func(issue *client.IssueRef) error) error {
+	failed := 0
+	for _, issue := range issues {
+		if err := fn(issue); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", issue.Identifier, err)
+			failed++
+			continue
+		}
+		fmt.Printf("%s: %s\n", issue.Identifier, done)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d issues could not be %s", failed, len(issues), done)
+	}
+	return nil
+}
+
+func pluralIssues(n int) string {
+	if n == 1 {
+		return "issue"
+	}
+	return "issues"
+}
+
+func init() {
+	issuesCmd.AddCommand(issuesArchiveCmd)
+	issuesCmd.AddCommand(issuesUnarchiveCmd)
+	issuesCmd.AddCommand(issuesRestoreCmd)
+	issuesCmd.AddCommand(issuesTrashCmd)
+	issuesTrashCmd.AddCommand(issuesTrashListCmd)
+
+	issuesTrashListCmd.Flags().StringP("team", "t", "", "Team name or ID (default from config, else all teams)")
+}
@@ -0,0 +1,275 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/junipery17/lineartui/internal/client"
+	"github.com/junipery17/lineartui/internal/rest"
+	"github.com/spf13/cobra"
+)
+
+// linearUploadsHost serves files uploaded to Linear. Only requests to it
+// carry credentials when downloading.
+const linearUploadsHost = "uploads.linear.app"
+
+var issuesAttachCmd = &cobra.Command{
+	Use:   "attach <issue> <file|url>...",
+	Short: "Attach files or links to an issue",
+	Long: `Upload files to Linear and attach them to an issue. Arguments that are
+http(s) URLs, such as links to pull requests, are attached as links.`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		title, _ := cmd.Flags().GetString("title")
+		if title != "" && len(args) > 2 {
+			return fmt.Errorf("--title can only be used with a single file or URL")
+		}
+		issue, err := resolveIssue(ctx, args[0])
+		if err != nil {
+			return err
+		}
+
+		failed := 0
+		for _, target := range args[1:] {
+			var attachment *client.AttachmentData
+			if isWebURL(target) {
+				attachment, err = linearClient.LinkURL(ctx, string(issue.ID), target, title)
+			} else {
+				attachment, err = uploadAttachment(ctx, string(issue.ID), target, title)
+			}
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "%s: %s\n", target, err)
+				failed++
+				continue
+			}
+			fmt.Printf("Attached %s to %s\n", attachment.Title, issue.Identifier)
+		}
+		if failed > 0 {
+			return fmt.Errorf("%d of %d attachments failed", failed, len(args)-1)
+		}
+		return nil
+	},
+}
+
+var issuesAttachmentsCmd = &cobra.Command{
+	Use:   "attachments <issue>",
+	Short: "List, and optionally download, the attachments of an issue",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		issueID, err := parseIssueRef(args[0])
+		if err != nil {
+			return err
+		}
+		attachments, err := linearClient.ListAttachments(ctx, issueID)
+		if err != nil {
+			return err
+		}
+		if dir, _ := cmd.Flags().GetString("download"); dir != "" {
+			return downloadAttachments(attachments, dir)
+		}
+
+		switch outputFormat {
+		case outputJSON:
+			if attachments == nil {
+				attachments = []client.AttachmentData{}
+			}
+			return printJSON(attachments)
+		case outputCSV:
+			rows := make([][]string, 0, len(attachments))
+			for _, attachment := range attachments {
+				rows = append(rows, []string{
+					string(attachment.ID),
+					string(attachment.Title),
+					attachmentKind(attachment),
+					string(attachment.URL),
+					attachment.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+				})
+			}
+			return printCSV([]string{"id", "title", "kind", "url", "created"}, rows)
+		}
+		if len(attachments) == 0 {
+			fmt.Println("No attachments.")
+			return nil
+		}
+		for _, attachment := range attachments {
+			fmt.Printf("%-6s %-40s %-14s %s\n", attachmentKind(attachment), truncate(string(attachment.Title), 40), relativeTime(attachment.CreatedAt), attachment.URL)
+		}
+		return nil
+	},
+}
+
+// uploadAttachment requests a signed upload URL, PUTs the file to it and
+// links the resulting asset to the issue.
+func uploadAttachment(ctx context.Context, issueID string, file string, title string) (*client.AttachmentData, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return nil, fmt.Errorf("is a directory")
+	}
+
+	contentType, err := detectContentType(f)
+	if err != nil {
+		return nil, err
+	}
+	name := filepath.Base(file)
+	upload, err := linearClient.FileUpload(ctx, contentType, name, info.Size())
+	if err != nil {
+		return nil, err
+	}
+
+	// The signed URL carries its own credentials, so no Linear
+	// authorization is sent along with the file.
+	storage := rest.NewClient("")
+	storage.SetHeader("Content-Type", contentType)
+	storage.SetHeader("Cache-Control", "public, max-age=31536000")
+	for _, header := range upload.Headers {
+		storage.SetHeader(string(header.Key), string(header.Value))
+	}
+	if err := storage.Upload(http.MethodPut, string(upload.UploadURL), f, info.Size()); err != nil {
+		return nil, err
+	}
+
+	if title == "" {
+		title = name
+	}
+	return linearClient.CreateAttachment(ctx, issueID, string(upload.AssetURL), title)
+}
+
+// detectContentType goes by the file extension, falling back to sniffing
+// the first bytes. f is rewound afterwards.
+func detectContentType(f *os.File) (string, error) {
+	if contentType := mime.TypeByExtension(filepath.Ext(f.Name())); contentType != "" {
+		return contentType, nil
+	}
+	head := make([]byte, 512)
+	n, err := f.Read(head)
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	return http.DetectContentType(head[:n]), nil
+}
+
+// downloadAttachments saves every uploaded file into dir. Links to other
+// sites are listed as skipped rather than fetched.
+func downloadAttachments(attachments []client.AttachmentData, dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	downloader, err := newUploadsClient()
+	if err != nil {
+		return err
+	}
+
+	files, failed := 0, 0
+	for _, attachment := range attachments {
+		if attachmentKind(attachment) != "file" {
+			fmt.Printf("Skipped %s (link)\n", attachment.URL)
+			continue
+		}
+		files++
+		target, err := downloadAttachment(downloader, attachment, dir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", attachment.Title, err)
+			failed++
+			continue
+		}
+		fmt.Printf("Saved %s\n", target)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d files could not be downloaded", failed, files)
+	}
+	return nil
+}
+
+func downloadAttachment(downloader *rest.Client, attachment client.AttachmentData, dir string) (string, error) {
+	name := filepath.Base(string(attachment.Title))
+	if name == "." || name == "/" || name == "" {
+		u, _ := url.Parse(string(attachment.URL))
+		name = path.Base(u.Path)
+	}
+	target := uniquePath(filepath.Join(dir, name))
+
+	f, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return "", err
+	}
+	if err := downloader.Download(string(attachment.URL), f); err != nil {
+		f.Close()
+		os.Remove(target)
+		return "", err
+	}
+	return target, f.Close()
+}
+
+// uniquePath adds " (1)", " (2)", ... before the extension until path does
+// not exist, so downloads never overwrite files.
+func uniquePath(path string) string {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return path
+	}
+	ext := filepath.Ext(path)
+	base := strings.TrimSuffix(path, ext)
+	for i := 1; ; i++ {
+		candidate := fmt.Sprintf("%s (%d)%s", base, i, ext)
+		if _, err := os.Stat(candidate); os.IsNotExist(err) {
+			return candidate
+		}
+	}
+}
+
+// newUploadsClient authenticates the same way as the API client, since
+// files on Linear's upload host are private to the workspace.
+func newUploadsClient() (*rest.Client, error) {
+	downloader := rest.NewClient("")
+	apiKey, err := cfg.ResolveAPIKey()
+	if err != nil {
+		return nil, err
+	}
+	if apiKey != "" {
+		downloader.SetAuthToken(apiKey)
+	} else {
+		downloader.HTTPClient = oauthHTTPClient()
+	}
+	return downloader, nil
+}
+
+func attachmentKind(attachment client.AttachmentData) string {
+	u, err := url.Parse(string(attachment.URL))
+	if err == nil && u.Host == linearUploadsHost {
+		return "file"
+	}
+	return "link"
+}
+
+func isWebURL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func init() {
+	issuesCmd.AddCommand(issuesAttachCmd)
+	issuesCmd.AddCommand(issuesAttachmentsCmd)
+
+	issuesAttachCmd.Flags().String("title", "", "Attachment title (default: the file name)")
+	issuesAttachmentsCmd.Flags().String("download", "", "Download uploaded files into this directory")
+}
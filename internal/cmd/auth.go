@@ -0,0 +1,179 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"runtime"
+	"time"
+
+	"github.com/junipery17/lineartui/internal/auth"
+	"github.com/junipery17/lineartui/internal/client"
+	"github.com/spf13/cobra"
+)
+
+var authCmd = &cobra.Command{
+	Use:   "auth",
+	Short: "Log in to Linear with OAuth",
+	Long:  `Authorize lineartui through Linear's OAuth2 flow instead of a personal API key.`,
+
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		return loadConfig()
+	},
+}
+
+var authLoginCmd = &cobra.Command{
+	Use:   "login",
+	Short: "Authorize lineartui in the browser",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		noBrowser, _ := cmd.Flags().GetBool("no-browser")
+		timeout, _ := cmd.Flags().GetDuration("timeout")
+
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		token, err := auth.Login(ctx, oauthConfig(), func(authURL string) error {
+			fmt.Printf("Open this URL to authorize lineartui:\n\n  %s\n\n", authURL)
+			if !noBrowser {
+				if err := openBrowser(authURL); err != nil {
+					fmt.Println("Could not open a browser, please open the URL manually.")
+				}
+			}
+			fmt.Println("Waiting for authorization...")
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if err := tokenStore().Save(token); err != nil {
+			return err
+		}
+
+		viewer, err := newOAuthClient().Viewer(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Logged in as %s (%s)\n", viewer.Name, viewer.Email)
+		return nil
+	},
+}
+
+var authStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the current authentication method",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		apiKey, err := cfg.ResolveAPIKey()
+		if err != nil {
+			return err
+		}
+		if apiKey != "" {
+			fmt.Printf("Using API key from %s\n", cfg.Origin("linear.api_key"))
+			viewer, err := client.NewClient(apiKey, cfg.Linear.APIURL).Viewer(ctx)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Authenticated as %s (%s)\n", viewer.Name, viewer.Email)
+			return nil
+		}
+
+		token, err := tokenStore().Load()
+		if err != nil {
+			return err
+		}
+		if token == nil {
+			fmt.Println("Not logged in. Run 'lineartui auth login' or configure linear.api_key.")
+			return nil
+		}
+		fmt.Printf("Using OAuth token from %s\n", cfg.OAuth.TokenFile)
+		if !token.Expiry.IsZero() {
+			fmt.Printf("Access token expires %s\n", token.Expiry.Format(time.RFC1123))
+		}
+		viewer, err := newOAuthClient().Viewer(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Authenticated as %s (%s)\n", viewer.Name, viewer.Email)
+		return nil
+	},
+}
+
+var authLogoutCmd = &cobra.Command{
+	Use:   "logout",
+	Short: "Revoke and remove the stored OAuth token",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store := tokenStore()
+		token, err := store.Load()
+		if err != nil {
+			return err
+		}
+		if token == nil {
+			fmt.Println("Not logged in.")
+			return nil
+		}
+		if err := auth.Revoke(context.Background(), oauthConfig(), token); err != nil {
+			fmt.Printf("warning: %s\n", err)
+		}
+		if err := store.Delete(); err != nil {
+			return err
+		}
+		fmt.Println("Logged out.")
+		return nil
+	},
+}
+
+func oauthConfig() *auth.Config {
+	return &auth.Config{
+		ClientID:     cfg.OAuth.ClientID,
+		ClientSecret: cfg.OAuth.ClientSecret,
+		AuthURL:      cfg.OAuth.AuthURL,
+		TokenURL:     cfg.OAuth.TokenURL,
+		RevokeURL:    cfg.OAuth.RevokeURL,
+		Scopes:       cfg.OAuth.Scopes,
+		RedirectPort: cfg.OAuth.RedirectPort,
+	}
+}
+
+func tokenStore() *auth.Store {
+	return auth.NewStore(cfg.OAuth.TokenFile)
+}
+
+func newOAuthClient() client.Client {
+	return client.NewClientWithHTTPClient(oauthHTTPClient(), cfg.Linear.APIURL)
+}
+
+// oauthHTTPClient authenticates requests with the stored OAuth token,
+// refreshing it when it has expired.
+func oauthHTTPClient() *http.Client {
+	return &http.Client{
+		Transport: &auth.Transport{
+			Config: oauthConfig(),
+			Store:  tokenStore(),
+			Base:   http.DefaultTransport,
+		},
+	}
+}
+
+func openBrowser(url string) error {
+	var c *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		c = exec.Command("open", url)
+	case "windows":
+		c = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		c = exec.Command("xdg-open", url)
+	}
+	return c.Start()
+}
+
+func init() {
+	rootCmd.AddCommand(authCmd)
+	authCmd.AddCommand(authLoginCmd)
+	authCmd.AddCommand(authStatusCmd)
+	authCmd.AddCommand(authLogoutCmd)
+
+	authLoginCmd.Flags().Bool("no-browser", false, "Print the authorization URL without opening a browser")
+	authLoginCmd.Flags().Duration("timeout", 5*time.Minute, "How long to wait for the browser callback")
+}
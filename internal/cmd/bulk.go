@@ -0,0 +1,542 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/junipery17/lineartui/internal/client"
+	"github.com/spf13/cobra"
+)
+
+var issuesBulkUpdateCmd = &cobra.Command{
+	Use:   "bulk-update [filter...|-]",
+	Short: "Apply the same changes to many issues",
+	Long: `Apply the same changes to every issue matching a filter, or to issue
+refs read from stdin when the argument is - or stdin is a pipe. Only the
+first word of each line is read, so the output of 'issues list' can be piped
+in as is:
+
+  lineartui issues bulk-update label:triage state:backlog --add-label bug -s todo
+  lineartui issues list -f 'project:"Q4 Infra"' | lineartui issues bulk-update - --cycle next
+
+Filters use the same syntax as 'issues list'. Issues that already have the
+requested values are skipped, --dry-run shows what would change, and the
+command fails if any issue could not be updated.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		workers, _ := cmd.Flags().GetInt("concurrency")
+		if workers < 1 || workers > maxBulkWorkers {
+			return fmt.Errorf("--concurrency must be between 1 and %d", maxBulkWorkers)
+		}
+		target, err := bulkTargetFromFlags(ctx, cmd)
+		if err != nil {
+			return err
+		}
+
+		issues, fromStdin, err := selectBulkIssues(ctx, cmd, args)
+		if err != nil {
+			return err
+		}
+		if len(issues) == 0 {
+			fmt.Println("No issues match.")
+			return nil
+		}
+		plan, err := planBulkUpdate(ctx, issues, target)
+		if err != nil {
+			return err
+		}
+		var pending []*bulkChange
+		for i := range plan {
+			if len(plan[i].Changes) > 0 {
+				pending = append(pending, &plan[i])
+			}
+		}
+
+		if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+			return printBulkPlan(pending, len(plan))
+		}
+		if len(pending) == 0 {
+			fmt.Printf("All %d %s are already up to date.\n", len(plan), pluralIssues(len(plan)))
+			return nil
+		}
+		// Refs read from stdin leave nothing to answer a prompt with.
+		if yes, _ := cmd.Flags().GetBool("yes"); !yes && !fromStdin && stdinIsTerminal() {
+			ok, err := confirm(fmt.Sprintf("Update %d %s?", len(pending), pluralIssues(len(pending))))
+			if err != nil {
+				return err
+			}
+			if !ok {
+				fmt.Println("Aborted.")
+				return nil
+			}
+		}
+		return runBulkUpdate(ctx, pending, target, workers, len(plan)-len(pending))
+	},
+}
+
+// maxBulkWorkers keeps bulk updates well inside Linear's rate limits.
+const maxBulkWorkers = 16
+
+// bulkTarget holds the requested values. Values that depend on the team,
+// states and cycles, are resolved per issue while planning.
+type bulkTarget struct {
+	state       string
+	assignee    string
+	assigneeID  string
+	priority    int
+	hasPriority bool
+	project     string
+	projectID   string
+	cycle       string
+	addLabels   []string
+	delLabels   []string
+}
+
+func bulkTargetFromFlags(ctx context.Context, cmd *cobra.Command) (*bulkTarget, error) {
+	flags := cmd.Flags()
+	target := &bulkTarget{}
+	target.state, _ = flags.GetString("status")
+	target.cycle, _ = flags.GetString("cycle")
+	add, _ := flags.GetStringSlice("add-label")
+	remove, _ := flags.GetStringSlice("remove-label")
+	target.addLabels, target.delLabels = trimAll(add), trimAll(remove)
+
+	if assign, _ := flags.GetString("assign"); assign != "" {
+		id, err := resolveAssignee(ctx, assign)
+		if err != nil {
+			return nil, err
+		}
+		target.assignee, target.assigneeID = assign, id
+	}
+	if value, _ := flags.GetString("priority"); value != "" {
+		priority, err := parsePriority(value)
+		if err != nil {
+			return nil, err
+		}
+		target.priority, target.hasPriority = priority, true
+	}
+	if project, _ := flags.GetString("project"); project != "" {
+		target.project = project
+		if !strings.EqualFold(project, "none") {
+			id, err := resolveProjectID(ctx, project)
+			if err != nil {
+				return nil, err
+			}
+			target.projectID = id
+		}
+	}
+
+	if target.state == "" && target.assignee == "" && !target.hasPriority && target.project == "" &&
+		target.cycle == "" && len(target.addLabels) == 0 && len(target.delLabels) == 0 {
+		return nil, fmt.Errorf("nothing to update, pass at least one of --status, --assign, --priority, --project, --cycle, --add-label or --remove-label")
+	}
+	return target, nil
+}
+
+// selectBulkIssues lists the issues matching the filter, or looks up the
+// refs read from stdin. fromStdin reports which of the two it did.
+func selectBulkIssues(ctx context.Context, cmd *cobra.Command, args []string) (issues []client.IssueSummary, fromStdin bool, err error) {
+	expr, _ := cmd.Flags().GetString("filter")
+	switch {
+	case slices.Contains(args, "-"):
+		if len(args) > 1 || expr != "" {
+			return nil, false, fmt.Errorf("cannot combine - with a filter")
+		}
+	case len(args) > 0 || expr != "":
+		issueFilter, err := buildIssueFilter(ctx, cmd, args, "", true)
+		if err != nil {
+			return nil, false, err
+		}
+		issues, err := linearClient.ListIssues(ctx, issueFilter, client.ListOptions{})
+		return issues, false, err
+	case stdinIsTerminal():
+		return nil, false, fmt.Errorf("select issues with a filter such as label:triage, or pipe issue refs in with -")
+	}
+
+	refs, err := readIssueRefs(stdinReader)
+	if err != nil {
+		return nil, true, err
+	}
+	issues, err = fetchIssueSummaries(ctx, refs)
+	return issues, true, err
+}
+
+// readIssueRefs takes the first word of every line, skipping blank lines
+// and # comments.
+func readIssueRefs(r io.Reader) ([]string, error) {
+	var refs []string
+	seen := make(map[string]bool)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		ref, err := parseIssueRef(fields[0])
+		if err != nil {
+			return nil, err
+		}
+		if !seen[ref] {
+			seen[ref] = true
+			refs = append(refs, ref)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read issue refs: %w", err)
+	}
+	return refs, nil
+}
+
+// fetchIssueSummaries looks every ref up before anything is changed, so an
+// unknown ref aborts the whole batch.
+func fetchIssueSummaries(ctx context.Context, refs []string) ([]client.IssueSummary, error) {
+	found, errs := linearClient.GetIssueSummaries(ctx, refs)
+	issues := make([]client.IssueSummary, 0, len(refs))
+	var messages []string
+	for i, err := range errs {
+		if err != nil {
+			messages = append(messages, err.Error())
+			continue
+		}
+		issues = append(issues, *found[i])
+	}
+	if len(messages) > 0 {
+		return nil, fmt.Errorf("%d of %d issues could not be found:\n  %s", len(messages), len(refs), strings.Join(messages, "\n  "))
+	}
+	return issues, nil
+}
+
+// runWorkers calls fn for 0..count-1 from at most workers goroutines.
+func runWorkers(workers int, count int, fn func(i int)) {
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(workers, count) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				fn(i)
+			}
+		}()
+	}
+	for i := range count {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+type fieldChange struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// bulkChange is what planning decided to change on one issue.
+type bulkChange struct {
+	Identifier string        `json:"identifier"`
+	Title      string        `json:"title"`
+	Changes    []fieldChange `json:"changes"`
+
+	issueID   string
+	input     client.IssueUpdateInput
+	addLabels []string
+	delLabels []string
+}
+
+// planBulkUpdate works out each issue's changes from the values it was
+// listed with, looking states and cycles up once per team.
+func planBulkUpdate(ctx context.Context, issues []client.IssueSummary, target *bulkTarget) ([]bulkChange, error) {
+	states := make(map[string][]client.WorkflowStateData)
+	cycles := make(map[string]string)
+	plan := make([]bulkChange, 0, len(issues))
+	for _, issue := range issues {
+		change := bulkChange{
+			Identifier: string(issue.Identifier),
+			Title:      string(issue.Title),
+			Changes:    []fieldChange{},
+			issueID:    string(issue.ID),
+			input:      client.IssueUpdateInput{},
+		}
+		teamID := string(issue.Team.ID)
+
+		if target.state != "" {
+			if _, ok := states[teamID]; !ok {
+				teamStates, err := linearClient.GetWorkflowStates(ctx, teamID)
+				if err != nil {
+					return nil, err
+				}
+				states[teamID] = teamStates
+			}
+			state, err := matchState(states[teamID], target.state)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", issue.Identifier, err)
+			}
+			if state.Name != issue.State.Name {
+				change.set("stateId", string(state.ID), "state", string(issue.State.Name), string(state.Name))
+			}
+		}
+		if target.assignee != "" {
+			current := ""
+			if issue.Assignee != nil {
+				current = string(issue.Assignee.ID)
+			}
+			if current != target.assigneeID {
+				// An empty ID means unassign, which Linear takes as null.
+				var value any = target.assigneeID
+				if target.assigneeID == "" {
+					value = nil
+				}
+				change.set("assigneeId", value, "assignee", orNone(assigneeName(&issue)), target.assignee)
+			}
+		}
+		if target.hasPriority && int(issue.Priority) != target.priority {
+			change.set("priority", target.priority, "priority", priorityNames[int(issue.Priority)], priorityNames[target.priority])
+		}
+		if target.project != "" {
+			current, from := "", "none"
+			if issue.Project != nil {
+				current, from = string(issue.Project.ID), string(issue.Project.Name)
+			}
+			if current != target.projectID {
+				var value any = target.projectID
+				if target.projectID == "" {
+					value = nil
+				}
+				change.set("projectId", value, "project", from, target.project)
+			}
+		}
+		if target.cycle != "" {
+			cycleID, ok := cycles[teamID]
+			if !ok && !strings.EqualFold(target.cycle, "none") {
+				var err error
+				cycleID, err = resolveCycle(ctx, teamID, target.cycle)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", issue.Identifier, err)
+				}
+				cycles[teamID] = cycleID
+			}
+			current, from := "", "none"
+			if issue.Cycle != nil {
+				current, from = string(issue.Cycle.ID), fmt.Sprintf("#%d", int(issue.Cycle.Number))
+			}
+			if current != cycleID {
+				var value any = cycleID
+				if cycleID == "" {
+					value = nil
+				}
+				change.set("cycleId", value, "cycle", from, target.cycle)
+			}
+		}
+		change.planLabels(issue, target)
+		plan = append(plan, change)
+	}
+	return plan, nil
+}
+
+func (c *bulkChange) set(key string, value any, field, from, to string) {
+	c.input[key] = value
+	c.Changes = append(c.Changes, fieldChange{Field: field, From: from, To: to})
+}
+
+// planLabels only keeps labels that change something. Linear replaces the
+// whole label set, so the set itself is computed when the update is sent.
+func (c *bulkChange) planLabels(issue client.IssueSummary, target *bulkTarget) {
+	has := func(name string) bool {
+		return slices.ContainsFunc(issue.Labels.Nodes, func(label client.NameRef) bool {
+			return strings.EqualFold(string(label.Name), name)
+		})
+	}
+	var diff []string
+	for _, name := range target.addLabels {
+		if !has(name) && !slices.Contains(c.addLabels, name) {
+			c.addLabels = append(c.addLabels, name)
+			diff = append(diff, "+"+name)
+		}
+	}
+	for _, name := range target.delLabels {
+		if has(name) && !slices.Contains(c.delLabels, name) {
+			c.delLabels = append(c.delLabels, name)
+			diff = append(diff, "-"+name)
+		}
+	}
+	if len(diff) > 0 {
+		names := make([]string, 0, len(issue.Labels.Nodes))
+		for _, label := range issue.Labels.Nodes {
+			names = append(names, string(label.Name))
+		}
+		c.Changes = append(c.Changes, fieldChange{Field: "labels", From: orNone(strings.Join(names, ", ")), To: strings.Join(diff, " ")})
+	}
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
+
+func printBulkPlan(pending []*bulkChange, total int) error {
+	if outputFormat == outputJSON {
+		if pending == nil {
+			pending = []*bulkChange{}
+		}
+		return printJSON(pending)
+	}
+	if outputFormat == outputCSV {
+		var rows [][]string
+		for _, change := range pending {
+			for _, field := range change.Changes {
+				rows = append(rows, []string{change.Identifier, field.Field, field.From, field.To})
+			}
+		}
+		return printCSV([]string{"identifier", "field", "from", "to"}, rows)
+	}
+	for _, change := range pending {
+		fmt.Printf("%s  %s\n", change.Identifier, truncate(change.Title, 60))
+		for _, field := range change.Changes {
+			if field.Field == "labels" {
+				fmt.Printf("    %-9s %s\n", field.Field, field.To)
+				continue
+			}
+			fmt.Printf("    %-9s %s → %s\n", field.Field, field.From, field.To)
+		}
+	}
+	fmt.Printf("Would update %d of %d %s.\n", len(pending), total, pluralIssues(total))
+	return nil
+}
+
+// bulkChunkSize is how many issues a worker updates with one batch.
+const bulkChunkSize = 25
+
+// runBulkUpdate hands the updates to a pool of workers in chunks, each sent
+// as a batch, and reports every issue as its chunk finishes.
+func runBulkUpdate(ctx context.Context, pending []*bulkChange, target *bulkTarget, workers int, unchanged int) error {
+	var addIDs []string
+	if len(target.addLabels) > 0 {
+		var err error
+		addIDs, err = linearClient.LabelIDs(ctx, target.addLabels)
+		if err != nil {
+			return err
+		}
+	}
+	labelIDs := make(map[string]string)
+	for i, name := range target.addLabels {
+		labelIDs[strings.ToLower(name)] = addIDs[i]
+	}
+
+	chunks := slices.Collect(slices.Chunk(pending, bulkChunkSize))
+	bar := newProgressBar(len(pending))
+	var failed []string
+	var mu sync.Mutex
+	runWorkers(workers, len(chunks), func(i int) {
+		for j, err := range applyBulkChunk(ctx, chunks[i], labelIDs) {
+			change := chunks[i][j]
+			if err != nil {
+				bar.Printf(os.Stderr, "%s: %s\n", change.Identifier, err)
+				mu.Lock()
+				failed = append(failed, change.Identifier)
+				mu.Unlock()
+			} else {
+				fields := make([]string, 0, len(change.Changes))
+				for _, field := range change.Changes {
+					fields = append(fields, field.Field)
+				}
+				bar.Printf(os.Stdout, "Updated %s: %s\n", change.Identifier, strings.Join(fields, ", "))
+			}
+			bar.Increment()
+		}
+	})
+	bar.Finish()
+
+	summary := fmt.Sprintf("Updated %d of %d %s", len(pending)-len(failed), len(pending), pluralIssues(len(pending)))
+	if unchanged > 0 {
+		summary += fmt.Sprintf(", %d already up to date", unchanged)
+	}
+	fmt.Println(summary + ".")
+	if len(failed) > 0 {
+		sort.Slice(failed, func(i, j int) bool { return compareIdentifiers(failed[i], failed[j]) < 0 })
+		return fmt.Errorf("%d of %d issues could not be updated: %s", len(failed), len(pending), strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// applyBulkChunk fetches the current labels of the issues whose labels
+// change, then sends all of the chunk's updates together.
+func applyBulkChunk(ctx context.Context, chunk []*bulkChange, labelIDs map[string]string) []error {
+	errs := make([]error, len(chunk))
+	var relabel []int
+	var relabelIDs []string
+	for i, change := range chunk {
+		if len(change.addLabels) > 0 || len(change.delLabels) > 0 {
+			relabel = append(relabel, i)
+			relabelIDs = append(relabelIDs, change.issueID)
+		}
+	}
+	inputs := make([]client.IssueUpdateInput, len(chunk))
+	for i, change := range chunk {
+		inputs[i] = change.input
+	}
+	if len(relabel) > 0 {
+		labelSets, labelErrs := linearClient.GetIssueLabelSets(ctx, relabelIDs)
+		for n, i := range relabel {
+			if labelErrs[n] != nil {
+				errs[i] = labelErrs[n]
+				continue
+			}
+			change := chunk[i]
+			ids := []string{}
+			for _, label := range labelSets[n] {
+				if !slices.ContainsFunc(change.delLabels, func(name string) bool { return strings.EqualFold(name, string(label.Name)) }) {
+					ids = append(ids, string(label.ID))
+				}
+			}
+			for _, name := range change.addLabels {
+				if id := labelIDs[strings.ToLower(name)]; !slices.Contains(ids, id) {
+					ids = append(ids, id)
+				}
+			}
+			inputs[i] = maps.Clone(change.input)
+			inputs[i]["labelIds"] = ids
+		}
+	}
+
+	var updates []client.IssueUpdate
+	var sent []int
+	for i, change := range chunk {
+		if errs[i] == nil {
+			updates = append(updates, client.IssueUpdate{IssueID: change.issueID, Input: inputs[i]})
+			sent = append(sent, i)
+		}
+	}
+	for n, err := range linearClient.UpdateIssues(ctx, updates) {
+		errs[sent[n]] = err
+	}
+	return errs
+}
+
+func init() {
+	issuesCmd.AddCommand(issuesBulkUpdateCmd)
+
+	flags := issuesBulkUpdateCmd.Flags()
+	flags.StringP("team", "t", "", "Team name or ID to select issues from")
+	flags.StringP("filter", "f", "", "Filter expression, e.g. 'label:triage state:backlog'")
+	flags.StringP("status", "s", "", "New state name or type")
+	flags.StringP("assign", "a", "", "Assignee by name, email, @me, or none to unassign")
+	flags.StringP("priority", "p", "", "New priority: 0-4 or none, urgent, high, medium, low")
+	flags.StringSlice("add-label", nil, "Comma-separated labels to add, created if missing")
+	flags.StringSlice("remove-label", nil, "Comma-separated labels to remove")
+	flags.String("project", "", "Project name or ID, or none to remove it")
+	flags.String("cycle", "", "Cycle: current, next, a number, a name, or none to remove it")
+	flags.Int("concurrency", 4, "Number of batches of issues to update at once")
+	flags.BoolP("dry-run", "n", false, "Print the planned changes without applying them")
+	flags.BoolP("yes", "y", false, "Update without asking for confirmation")
+}
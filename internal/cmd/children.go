@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/junipery17/lineartui/internal/client"
+	"github.com/spf13/cobra"
+)
+
+var issuesChildrenCmd = &cobra.Command{
+	Use:   "children <issue>",
+	Short: "Show the sub-issues of an issue",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		ref, err := resolveIssue(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		// Fetch the parent through the listing query too, so its own
+		// estimate and state count towards the roll-up.
+		includeArchived, _ := cmd.Flags().GetBool("include-archived")
+		parent, err := linearClient.ListIssues(ctx, client.IssueFilter{"id": map[string]any{"eq": string(ref.ID)}}, client.ListOptions{Limit: 1, IncludeArchived: true})
+		if err != nil {
+			return err
+		}
+		if len(parent) == 0 {
+			return fmt.Errorf("issue %s not found", args[0])
+		}
+		recursive, _ := cmd.Flags().GetBool("recursive")
+		children, err := fetchChildren(ctx, string(ref.ID), recursive, includeArchived)
+		if err != nil {
+			return err
+		}
+		if len(children) == 0 && outputFormat == outputText {
+			fmt.Printf("%s has no sub-issues.\n", ref.Identifier)
+			return nil
+		}
+		// A parent's own parent is not in the list, so it is the only root.
+		return printIssueTree(buildIssueTree(append(parent, children...)), false)
+	},
+}
+
+func init() {
+	issuesCmd.AddCommand(issuesChildrenCmd)
+	issuesChildrenCmd.Flags().BoolP("recursive", "r", false, "Include sub-issues of sub-issues")
+	issuesChildrenCmd.Flags().Bool("include-archived", false, "Include archived sub-issues")
+}
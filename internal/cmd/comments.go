@@ -0,0 +1,261 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/junipery17/lineartui/internal/client"
+	"github.com/shurcooL/graphql"
+	"github.com/spf13/cobra"
+)
+
+var issuesCommentCmd = &cobra.Command{
+	Use:     "comment",
+	Aliases: []string{"comments"},
+	Short:   "Read and write comments on issues",
+	Long: `Add, list, edit, delete and resolve issue comments.
+
+Comment text is markdown. It is taken from --body, from stdin when input is
+piped (or --body -), and otherwise written in $EDITOR.`,
+}
+
+var commentAddCmd = &cobra.Command{
+	Use:   "add <issue>",
+	Short: "Comment on an issue",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		issue, err := resolveIssue(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		input := client.CommentCreateInput{IssueID: issue.ID}
+		if replyTo, _ := cmd.Flags().GetString("reply-to"); replyTo != "" {
+			parent, err := linearClient.GetComment(ctx, replyTo)
+			if err != nil {
+				return err
+			}
+			// Linear threads are one level deep, so replying to a reply
+			// continues its thread.
+			input.ParentID = parent.ID
+			if parent.Parent != nil {
+				input.ParentID = parent.Parent.ID
+			}
+		}
+		body, err := readBody(cmd, "")
+		if err != nil {
+			return err
+		}
+		input.Body = graphql.String(body)
+
+		comment, err := linearClient.CreateComment(ctx, input)
+		if err != nil {
+			return err
+		}
+		if outputFormat == outputJSON {
+			return printJSON(comment)
+		}
+		fmt.Printf("Commented on %s: %s\n", issue.Identifier, comment.URL)
+		return nil
+	},
+}
+
+var commentListCmd = &cobra.Command{
+	Use:   "list <issue>",
+	Short: "List the comments on an issue, grouped into threads",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		issueID, err := parseIssueRef(args[0])
+		if err != nil {
+			return err
+		}
+		comments, err := linearClient.ListComments(context.Background(), issueID)
+		if err != nil {
+			return err
+		}
+		switch outputFormat {
+		case outputJSON:
+			if comments == nil {
+				comments = []client.CommentData{}
+			}
+			return printJSON(comments)
+		case outputCSV:
+			rows := make([][]string, 0, len(comments))
+			for _, comment := range comments {
+				rows = append(rows, commentRow(comment))
+			}
+			return printCSV([]string{"id", "parent", "author", "created", "resolved", "body"}, rows)
+		}
+		if len(comments) == 0 {
+			fmt.Println("No comments.")
+			return nil
+		}
+		printCommentThreads(comments)
+		return nil
+	},
+}
+
+var commentEditCmd = &cobra.Command{
+	Use:   "edit <comment-id>",
+	Short: "Edit a comment",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		comment, err := linearClient.GetComment(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		body, err := readBody(cmd, string(comment.Body))
+		if err != nil {
+			return err
+		}
+		if body == string(comment.Body) {
+			fmt.Println("Comment unchanged.")
+			return nil
+		}
+		if err := linearClient.UpdateComment(ctx, string(comment.ID), body); err != nil {
+			return err
+		}
+		fmt.Println("Comment updated.")
+		return nil
+	},
+}
+
+var commentDeleteCmd = &cobra.Command{
+	Use:   "delete <comment-id>",
+	Short: "Delete a comment",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		comment, err := linearClient.GetComment(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		if yes, _ := cmd.Flags().GetBool("yes"); !yes {
+			fmt.Printf("%s\n\n", indent(renderMarkdown(string(comment.Body)), "  "))
+			ok, err := confirm("Delete this comment?")
+			if err != nil {
+				return err
+			}
+			if !ok {
+				fmt.Println("Aborted.")
+				return nil
+			}
+		}
+		if err := linearClient.DeleteComment(ctx, string(comment.ID)); err != nil {
+			return err
+		}
+		fmt.Println("Comment deleted.")
+		return nil
+	},
+}
+
+var commentResolveCmd = &cobra.Command{
+	Use:   "resolve <comment-id>",
+	Short: "Resolve a comment thread",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		comment, err := linearClient.GetComment(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		// Threads are resolved through their first comment.
+		threadID := string(comment.ID)
+		if comment.Parent != nil {
+			threadID = string(comment.Parent.ID)
+		}
+		undo, _ := cmd.Flags().GetBool("undo")
+		if err := linearClient.ResolveComment(ctx, threadID, !undo); err != nil {
+			return err
+		}
+		if undo {
+			fmt.Println("Thread reopened.")
+		} else {
+			fmt.Println("Thread resolved.")
+		}
+		return nil
+	},
+}
+
+// printCommentThreads shows each top-level comment followed by its replies.
+func printCommentThreads(comments []client.CommentData) {
+	replies := make(map[graphql.String][]client.CommentData)
+	for _, comment := range comments {
+		if comment.Parent != nil {
+			replies[comment.Parent.ID] = append(replies[comment.Parent.ID], comment)
+		}
+	}
+	first := true
+	for _, comment := range comments {
+		if comment.Parent != nil {
+			continue
+		}
+		if !first {
+			fmt.Println()
+		}
+		first = false
+		printComment(comment, "")
+		for _, reply := range replies[comment.ID] {
+			printComment(reply, "    ")
+		}
+	}
+}
+
+func printComment(comment client.CommentData, prefix string) {
+	var notes []string
+	if comment.EditedAt != nil {
+		notes = append(notes, "edited")
+	}
+	if comment.ResolvedAt != nil {
+		notes = append(notes, "resolved")
+	}
+	header := fmt.Sprintf("%s, %s", commentAuthor(comment), relativeTime(comment.CreatedAt))
+	if len(notes) > 0 {
+		header += " (" + strings.Join(notes, ", ") + ")"
+	}
+	fmt.Printf("%s%s  %s\n", prefix, header, comment.ID)
+	fmt.Println(indent(renderMarkdown(string(comment.Body)), prefix+"  "))
+}
+
+func commentAuthor(comment client.CommentData) string {
+	if comment.User == nil {
+		return "Unknown"
+	}
+	return string(comment.User.Name)
+}
+
+func commentRow(comment client.CommentData) []string {
+	parent := ""
+	if comment.Parent != nil {
+		parent = string(comment.Parent.ID)
+	}
+	resolved := ""
+	if comment.ResolvedAt != nil {
+		resolved = comment.ResolvedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	return []string{
+		string(comment.ID),
+		parent,
+		commentAuthor(comment),
+		comment.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		resolved,
+		string(comment.Body),
+	}
+}
+
+func init() {
+	issuesCmd.AddCommand(issuesCommentCmd)
+	issuesCommentCmd.AddCommand(commentAddCmd)
+	issuesCommentCmd.AddCommand(commentListCmd)
+	issuesCommentCmd.AddCommand(commentEditCmd)
+	issuesCommentCmd.AddCommand(commentDeleteCmd)
+	issuesCommentCmd.AddCommand(commentResolveCmd)
+
+	commentAddCmd.Flags().StringP("body", "b", "", "Comment text in markdown, - to read stdin")
+	commentAddCmd.Flags().String("reply-to", "", "Comment ID to reply to")
+	commentEditCmd.Flags().StringP("body", "b", "", "New comment text in markdown, - to read stdin")
+	commentDeleteCmd.Flags().BoolP("yes", "y", false, "Delete without asking for confirmation")
+	commentResolveCmd.Flags().Bool("undo", false, "Reopen a resolved thread")
+}
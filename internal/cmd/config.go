@@ -0,0 +1,268 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/junipery17/lineartui/internal/client"
+	"github.com/junipery17/lineartui/internal/config"
+	"github.com/spf13/cobra"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Inspect and edit lineartui configuration",
+	Long:  `Set up, inspect and edit the configuration, and show where each value was loaded from.`,
+
+	// Config commands must work before an API key exists, so they only
+	// load the config and never build a client.
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		return loadConfig()
+	},
+}
+
+var configShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Print the effective configuration",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		showOrigin, _ := cmd.Flags().GetBool("origin")
+		for _, entry := range cfg.Entries() {
+			value := formatConfigValue(entry.Key, entry.Value)
+			if showOrigin {
+				fmt.Printf("%s = %s\t(%s)\n", entry.Key, value, entry.Origin)
+			} else {
+				fmt.Printf("%s = %s\n", entry.Key, value)
+			}
+		}
+		return nil
+	},
+}
+
+var configInitCmd = &cobra.Command{
+	Use:   "init",
+	Short: "Interactively create a config file",
+	Long:  `Prompt for an API key, verify it against Linear, pick a default team and write the config file.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path := configTarget("")
+		file, err := config.LoadFile(path)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Writing configuration to %s\n", path)
+		fmt.Println("Create a personal API key at https://linear.app/settings/api")
+
+		apiKey, err := promptSecret("Linear API key")
+		if err != nil {
+			return err
+		}
+		if apiKey == "" {
+			return fmt.Errorf("an API key is required")
+		}
+
+		ctx := context.Background()
+		c := client.NewClient(apiKey, cfg.Linear.APIURL)
+		viewer, err := c.Viewer(ctx)
+		if err != nil {
+			return fmt.Errorf("could not verify API key: %w", err)
+		}
+		fmt.Printf("Authenticated as %s (%s)\n", viewer.Name, viewer.Email)
+
+		teams, err := c.GetTeams(ctx)
+		if err != nil {
+			return err
+		}
+		teamID := ""
+		if len(teams) > 0 {
+			for i, team := range teams {
+				fmt.Printf("%d: %s\n", i+1, team.Name)
+			}
+			choice, err := prompt("Default team", "1")
+			if err != nil {
+				return err
+			}
+			n, err := strconv.Atoi(choice)
+			if err != nil || n < 1 || n > len(teams) {
+				return fmt.Errorf("choose a team number from 1 to %d", len(teams))
+			}
+			teamID = string(teams[n-1].ID)
+		}
+
+		if err := file.Set("linear.api_key", apiKey); err != nil {
+			return err
+		}
+		if teamID != "" {
+			if err := file.Set("linear.team_id", teamID); err != nil {
+				return err
+			}
+		}
+		if err := file.Save(); err != nil {
+			return err
+		}
+		fmt.Printf("Saved %s\n", path)
+		warnShadowed(path, "linear.api_key", "linear.team_id")
+		return nil
+	},
+}
+
+var configGetCmd = &cobra.Command{
+	Use:   "get <key>",
+	Short: "Print the effective value of a config key",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		key := strings.ToLower(args[0])
+		if err := config.ValidateKey(key); err != nil {
+			return err
+		}
+		fmt.Println(formatConfigValue(key, cfg.Get(key)))
+		return nil
+	},
+}
+
+var configSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Set a config key in the config file",
+	Long: `Set a config key in the file it is currently set in, so the new value
+takes effect, or else in the personal config file (~/.lcli.yaml when it
+exists). --configfile picks the file explicitly.`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		key := strings.ToLower(args[0])
+		path := configTarget(key)
+		file, err := config.LoadFile(path)
+		if err != nil {
+			return err
+		}
+		if err := file.Set(key, args[1]); err != nil {
+			return err
+		}
+		if err := file.Save(); err != nil {
+			return err
+		}
+		warnShadowed(path, key)
+		return nil
+	},
+}
+
+var configUnsetCmd = &cobra.Command{
+	Use:   "unset <key>",
+	Short: "Remove a config key from the config file",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		key := strings.ToLower(args[0])
+		path := configTarget(key)
+		file, err := config.LoadFile(path)
+		if err != nil {
+			return err
+		}
+		if err := file.Unset(key); err != nil {
+			return err
+		}
+		if err := file.Save(); err != nil {
+			return err
+		}
+		warnShadowed(path, key)
+		return nil
+	},
+}
+
+var configEditCmd = &cobra.Command{
+	Use:   "edit",
+	Short: "Open the config file in $EDITOR",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path := configTarget("")
+		file, err := config.LoadFile(path)
+		if err != nil {
+			return err
+		}
+		// Make sure the file exists with safe permissions before editing.
+		if err := file.Save(); err != nil {
+			return err
+		}
+		if err := openEditor(path); err != nil {
+			return err
+		}
+		file, err = config.LoadFile(path)
+		if err != nil {
+			return err
+		}
+		return file.Validate()
+	},
+}
+
+// configTarget is the file that config init/set/unset/edit write to: the
+// --configfile, else the file key currently comes from, else the personal
+// file. The system file is never written.
+func configTarget(key string) string {
+	if cfgFile != "" {
+		return cfgFile
+	}
+	layers := config.Layers()
+	if key != "" {
+		origin := cfg.Origin(key)
+		for _, layer := range layers {
+			if layer.Path == origin && layer.Name != "system" {
+				return origin
+			}
+		}
+	}
+	// ~/.lcli.yaml ranks above the XDG file, so once it exists it is the
+	// personal file in effect.
+	for _, layer := range layers {
+		if layer.Name == "home" {
+			if _, err := os.Stat(layer.Path); err == nil {
+				return layer.Path
+			}
+		}
+	}
+	return config.UserFile()
+}
+
+// warnShadowed warns about keys just written to path whose effective value
+// still comes from a higher layer or an environment variable.
+func warnShadowed(path string, keys ...string) {
+	rank := func(file string) int {
+		for i, layer := range config.Layers() {
+			if layer.Path == file {
+				return i
+			}
+		}
+		return -1
+	}
+	for _, key := range keys {
+		origin := cfg.Origin(key)
+		if strings.HasPrefix(origin, "env ") || (origin != path && rank(origin) > rank(path)) {
+			fmt.Fprintf(os.Stderr, "warning: %s is overridden by %s\n", key, origin)
+		}
+	}
+}
+
+// formatConfigValue renders a value for display, masking secrets.
+func formatConfigValue(key string, value any) string {
+	s := fmt.Sprint(value)
+	if s == "" || !isSecretKey(key) {
+		return s
+	}
+	if len(s) <= 8 {
+		return "****"
+	}
+	return s[:4] + "****" + s[len(s)-4:]
+}
+
+func isSecretKey(key string) bool {
+	return strings.HasSuffix(key, "api_key") || strings.HasSuffix(key, "token") || strings.HasSuffix(key, "secret")
+}
+
+func init() {
+	rootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(configShowCmd)
+	configCmd.AddCommand(configInitCmd)
+	configCmd.AddCommand(configGetCmd)
+	configCmd.AddCommand(configSetCmd)
+	configCmd.AddCommand(configUnsetCmd)
+	configCmd.AddCommand(configEditCmd)
+
+	configShowCmd.Flags().Bool("origin", false, "Show the source of each value")
+}
@@ -1,11 +0,0 @@
-package cmd
-
-var StatusToID map[string]string = map[string]string{
-	"backlog":     "e7e89370-c418-4e21-b928-2fb0b9e480b8",
-	"todo":        "fe37427f-ee63-4490-9255-94ede76e7362",
-	"in progress": "060d4cfd-d6fb-4f9b-a585-957882d8c7e5",
-	"in review":   "bd3ff784-8766-43fd-8728-dee09add4400",
-	"done":        "c6b1ba1e-916f-454a-bc65-7d1c0c73a6cd",
-	"canceled":    "c92d0b57-648c-47fb-925d-488fd3abe9ee",
-	"duplicate":   "bac9da53-c9a1-4f4b-aad7-ef84e64ab586",
-}
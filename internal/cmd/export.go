@@ -0,0 +1,350 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/junipery17/lineartui/internal/client"
+	"github.com/spf13/cobra"
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export every issue of a team for backups and audits",
+	Long: `Export every issue of a team with its comments, labels, relations,
+attachment metadata and history. Issues are fetched a page at a time and
+written as they arrive, so teams of any size export in constant memory.
+
+Formats:
+  jsonl     one JSON object per issue (default)
+  csv       one row per issue; comments and history are counted, not copied
+  markdown  a directory with one file per issue and an index.md
+
+  lineartui export --team ENG --out eng-2026-q4.jsonl
+  lineartui export --team ENG --format markdown --out snapshots/eng`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		format, _ := cmd.Flags().GetString("format")
+		out, _ := cmd.Flags().GetString("out")
+		team, _ := cmd.Flags().GetString("team")
+		includeArchived, _ := cmd.Flags().GetBool("include-archived")
+
+		teamID, err := resolveTeamID(ctx, team)
+		if err != nil {
+			return err
+		}
+		writer, err := newExportWriter(format, out)
+		if err != nil {
+			return err
+		}
+
+		issueFilter := client.IssueFilter{"team": map[string]any{"id": map[string]any{"eq": teamID}}}
+		opts := client.ListOptions{IncludeArchived: includeArchived}
+		exported := 0
+		err = linearClient.EachIssuePage(ctx, issueFilter, opts, func(page []client.IssueSummary) error {
+			ids := make([]string, len(page))
+			for i, issue := range page {
+				ids[i] = string(issue.ID)
+			}
+			issues, errs := linearClient.GetIssues(ctx, ids)
+			for i, issue := range issues {
+				// A snapshot with holes is worse than none, so any
+				// failure stops the export.
+				if errs[i] != nil {
+					return errs[i]
+				}
+				if err := writer.Write(issue); err != nil {
+					return err
+				}
+				exported++
+			}
+			if stderrIsTerminal() {
+				fmt.Fprintf(os.Stderr, "\rExported %d %s", exported, pluralIssues(exported))
+			}
+			return nil
+		})
+		if stderrIsTerminal() && exported > 0 {
+			fmt.Fprintln(os.Stderr)
+		}
+		if closeErr := writer.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return err
+		}
+		if out != "" {
+			fmt.Fprintf(os.Stderr, "Exported %d %s to %s\n", exported, pluralIssues(exported), out)
+		}
+		return nil
+	},
+}
+
+// exportWriter writes issues one at a time in one of the export formats.
+type exportWriter interface {
+	Write(issue *client.IssueDetail) error
+	Close() error
+}
+
+func newExportWriter(format string, out string) (exportWriter, error) {
+	switch format {
+	case "markdown", "md":
+		if out == "" || out == "-" {
+			return nil, fmt.Errorf("the markdown format writes a directory, pass it with --out")
+		}
+		return newMarkdownExport(out)
+	case "jsonl", "json":
+		w, err := createExportFile(out)
+		if err != nil {
+			return nil, err
+		}
+		return &jsonlExport{file: w, w: bufio.NewWriter(w)}, nil
+	case "csv":
+		w, err := createExportFile(out)
+		if err != nil {
+			return nil, err
+		}
+		return &csvExport{file: w, w: csv.NewWriter(w)}, nil
+	}
+	return nil, fmt.Errorf("unknown export format %q, use jsonl, csv or markdown", format)
+}
+
+// createExportFile opens out for writing, or stdout when out is empty or -.
+func createExportFile(out string) (io.WriteCloser, error) {
+	if out == "" || out == "-" {
+		return nopCloser{os.Stdout}, nil
+	}
+	if dir := filepath.Dir(out); dir != "." {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, err
+		}
+	}
+	return os.Create(out)
+}
+
+type nopCloser struct{ io.Writer }
+
+func (nopCloser) Close() error { return nil }
+
+type jsonlExport struct {
+	file io.WriteCloser
+	w    *bufio.Writer
+}
+
+func (e *jsonlExport) Write(issue *client.IssueDetail) error {
+	raw, err := json.Marshal(issue)
+	if err != nil {
+		return err
+	}
+	if _, err := e.w.Write(append(raw, '\n')); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (e *jsonlExport) Close() error {
+	if err := e.w.Flush(); err != nil {
+		e.file.Close()
+		return err
+	}
+	return e.file.Close()
+}
+
+var exportColumns = []string{
+	"identifier", "title", "state", "stateType", "priority", "estimate", "due",
+	"assignee", "creator", "labels", "project", "cycle", "parent", "children",
+	"relations", "attachments", "comments", "historyEvents",
+	"created", "updated", "started", "completed", "canceled", "archived",
+	"url", "description",
+}
+
+type csvExport struct {
+	file        io.WriteCloser
+	w           *csv.Writer
+	wroteHeader bool
+}
+
+func (e *csvExport) Write(issue *client.IssueDetail) error {
+	if !e.wroteHeader {
+		e.wroteHeader = true
+		if err := e.w.Write(exportColumns); err != nil {
+			return err
+		}
+	}
+	estimate, due, assignee, creator, project, cycle, parent := "", "", "", "", "", "", ""
+	if issue.Estimate != nil {
+		estimate = formatEstimate(float64(*issue.Estimate))
+	}
+	if issue.DueDate != nil {
+		due = string(*issue.DueDate)
+	}
+	if issue.Assignee != nil {
+		assignee = formatUser(issue.Assignee)
+	}
+	if issue.Creator != nil {
+		creator = formatUser(issue.Creator)
+	}
+	if issue.Project != nil {
+		project = string(issue.Project.Name)
+	}
+	if issue.Cycle != nil {
+		cycle = fmt.Sprint(int(issue.Cycle.Number))
+	}
+	if issue.Parent != nil {
+		parent = string(issue.Parent.Identifier)
+	}
+	children := make([]string, 0, len(issue.Children.Nodes))
+	for _, child := range issue.Children.Nodes {
+		children = append(children, string(child.Identifier))
+	}
+	var relations []string
+	for _, relation := range issue.Relations.Nodes {
+		relations = append(relations, relationVerb(string(relation.Type), false)+" "+string(relation.RelatedIssue.Identifier))
+	}
+	for _, relation := range issue.InverseRelations.Nodes {
+		relations = append(relations, relationVerb(string(relation.Type), true)+" "+string(relation.Issue.Identifier))
+	}
+	attachments := make([]string, 0, len(issue.Attachments.Nodes))
+	for _, attachment := range issue.Attachments.Nodes {
+		attachments = append(attachments, string(attachment.URL))
+	}
+	historyEvents := 0
+	for _, entry := range issue.History.Nodes {
+		historyEvents += len(historyChanges(entry))
+	}
+	return e.w.Write([]string{
+		string(issue.Identifier),
+		string(issue.Title),
+		string(issue.State.Name),
+		string(issue.State.Type),
+		string(issue.PriorityLabel),
+		estimate,
+		due,
+		assignee,
+		creator,
+		strings.Join(issueLabelNames(issue), ", "),
+		project,
+		cycle,
+		parent,
+		strings.Join(children, ", "),
+		strings.Join(relations, "; "),
+		strings.Join(attachments, " "),
+		fmt.Sprint(len(issue.Comments.Nodes)),
+		fmt.Sprint(historyEvents),
+		exportTime(&issue.CreatedAt),
+		exportTime(&issue.UpdatedAt),
+		exportTime(issue.StartedAt),
+		exportTime(issue.CompletedAt),
+		exportTime(issue.CanceledAt),
+		exportTime(issue.ArchivedAt),
+		string(issue.URL),
+		string(issue.Description),
+	})
+}
+
+func (e *csvExport) Close() error {
+	e.w.Flush()
+	if err := e.w.Error(); err != nil {
+		e.file.Close()
+		return err
+	}
+	return e.file.Close()
+}
+
+func exportTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// markdownExport writes <identifier>.md per issue and appends a line per
+// issue to index.md as it goes.
+type markdownExport struct {
+	dir   string
+	index *os.File
+}
+
+func newMarkdownExport(dir string) (*markdownExport, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	index, err := os.Create(filepath.Join(dir, "index.md"))
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(index, "# Issues\n\nExported %s.\n\n", time.Now().UTC().Format(time.RFC3339))
+	return &markdownExport{dir: dir, index: index}, nil
+}
+
+func (e *markdownExport) Write(issue *client.IssueDetail) error {
+	name := string(issue.Identifier) + ".md"
+	if err := os.WriteFile(filepath.Join(e.dir, name), []byte(issueMarkdown(issue)), 0o644); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(e.index, "- [%s](%s) %s [%s]\n", issue.Identifier, name, markdownEscape(string(issue.Title)), issue.State.Name)
+	return err
+}
+
+func (e *markdownExport) Close() error {
+	return e.index.Close()
+}
+
+func issueMarkdown(issue *client.IssueDetail) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s: %s\n\n", issue.Identifier, markdownEscape(string(issue.Title)))
+	stamp := func(t time.Time) string { return exportTime(&t) }
+	for _, field := range issueFields(issue, stamp)[2:] {
+		fmt.Fprintf(&b, "- **%s:** %s\n", field[0], markdownEscape(field[1]))
+	}
+
+	if issue.Description != "" {
+		fmt.Fprintf(&b, "\n## Description\n\n%s\n", strings.TrimRight(string(issue.Description), "\n"))
+	}
+	if len(issue.Comments.Nodes) > 0 {
+		b.WriteString("\n## Comments\n")
+		for _, comment := range issue.Comments.Nodes {
+			author := "Unknown"
+			if comment.User != nil {
+				author = string(comment.User.Name)
+			}
+			fmt.Fprintf(&b, "\n### %s, %s\n\n%s\n", author, exportTime(&comment.CreatedAt), strings.TrimRight(string(comment.Body), "\n"))
+		}
+	}
+	if len(issue.History.Nodes) > 0 {
+		b.WriteString("\n## History\n\n")
+		for _, entry := range issue.History.Nodes {
+			actor := "Linear"
+			if entry.Actor != nil {
+				actor = string(entry.Actor.Name)
+			}
+			for _, change := range historyChanges(entry) {
+				fmt.Fprintf(&b, "- %s %s: %s\n", exportTime(&entry.CreatedAt), actor, markdownEscape(change))
+			}
+		}
+	}
+	return b.String()
+}
+
+// markdownEscape keeps titles and field values from being read as Markdown
+// links, emphasis or HTML.
+func markdownEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`, "<", `\<`, "`", "\\`").Replace(s)
+}
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+
+	exportCmd.Flags().StringP("team", "t", "", "Team name or ID to export (default from config)")
+	exportCmd.Flags().String("format", "jsonl", "Export format: jsonl, csv or markdown")
+	exportCmd.Flags().String("out", "", "File to write, or directory for markdown (default stdout)")
+	exportCmd.Flags().Bool("include-archived", true, "Include archived issues")
+}
@@ -0,0 +1,178 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/junipery17/lineartui/internal/client"
+)
+
+// parsePriority accepts 0-4 or a priority name such as urgent or none.
+func parsePriority(value string) (int, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if n, err := strconv.Atoi(value); err == nil && n >= 0 && n < len(priorityNames) {
+		return n, nil
+	}
+	for i, name := range priorityNames {
+		if value == strings.ToLower(name) {
+			return i, nil
+		}
+	}
+	if value == "none" {
+		return 0, nil
+	}
+	return 0, fmt.Errorf("priority must be 0-4 or none, urgent, high, medium or low, got %q", value)
+}
+
+// estimateScales lists the points each estimation type allows, followed by
+// the points an extended scale adds.
+var estimateScales = map[string]struct{ base, extended []int }{
+	"exponential": {[]int{1, 2, 4, 8, 16}, []int{32, 64}},
+	"fibonacci":   {[]int{1, 2, 3, 5, 8}, []int{13, 21}},
+	"linear":      {[]int{1, 2, 3, 4, 5}, []int{6, 7}},
+	"tShirt":      {[]int{1, 2, 3, 5, 8}, []int{13, 21}},
+}
+
+var tShirtSizes = []string{"XS", "S", "M", "L", "XL", "XXL", "XXXL"}
+
+// parseEstimate checks value against the team's estimation scale. T-shirt
+// teams may give sizes such as M or XL as well as their point values.
+func parseEstimate(ctx context.Context, teamID string, value string) (int, error) {
+	estimation, err := linearClient.GetTeamEstimation(ctx, teamID)
+	if err != nil {
+		return 0, err
+	}
+	kind := string(estimation.Type)
+	scale, ok := estimateScales[kind]
+	if !ok {
+		return 0, fmt.Errorf("the team does not use estimates")
+	}
+	points := scale.base
+	if estimation.Extended {
+		points = append(append([]int{}, points...), scale.extended...)
+	}
+	if estimation.AllowZero {
+		points = append([]int{0}, points...)
+	}
+
+	value = strings.TrimSpace(value)
+	if kind == "tShirt" {
+		for i, size := range tShirtSizes {
+			if strings.EqualFold(value, size) {
+				if i >= len(scale.base) && !estimation.Extended {
+					break
+				}
+				return append(scale.base, scale.extended...)[i], nil
+			}
+		}
+	}
+	n, err := strconv.Atoi(value)
+	if err == nil {
+		for _, point := range points {
+			if n == point {
+				return n, nil
+			}
+		}
+	}
+
+	valid := make([]string, 0, len(points))
+	for i, point := range points {
+		label := strconv.Itoa(point)
+		if kind == "tShirt" && point != 0 {
+			offset := i
+			if estimation.AllowZero {
+				offset--
+			}
+			label = fmt.Sprintf("%s (%d)", tShirtSizes[offset], point)
+		}
+		valid = append(valid, label)
+	}
+	return 0, fmt.Errorf("estimate %q is not on the team's %s scale: %s", value, kind, strings.Join(valid, ", "))
+}
+
+var relativeDuePattern = regexp.MustCompile(`^(?:in\s+)?(\d+)\s*(d|day|days|w|week|weeks|m|month|months)$`)
+
+// parseDueDate accepts 2006-01-02, today, tomorrow, a weekday ("friday" and
+// "next friday" both mean the coming Friday), "next week", "next month" and
+// offsets such as 3d, 2w or "in 10 days".
+func parseDueDate(value string, now time.Time) (string, error) {
+	value = strings.ToLower(strings.Join(strings.Fields(value), " "))
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	if t, err := time.ParseInLocation("2006-01-02", value, now.Location()); err == nil {
+		return t.Format("2006-01-02"), nil
+	}
+
+	var due time.Time
+	switch value {
+	case "today":
+		due = today
+	case "tomorrow":
+		due = today.AddDate(0, 0, 1)
+	case "next week":
+		due = today.AddDate(0, 0, 7)
+	case "next month":
+		due = today.AddDate(0, 1, 0)
+	default:
+		if match := relativeDuePattern.FindStringSubmatch(value); match != nil {
+			n, _ := strconv.Atoi(match[1])
+			switch match[2][0] {
+			case 'd':
+				due = today.AddDate(0, 0, n)
+			case 'w':
+				due = today.AddDate(0, 0, 7*n)
+			case 'm':
+				due = today.AddDate(0, n, 0)
+			}
+			break
+		}
+		day := strings.TrimPrefix(value, "next ")
+		for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+			name := strings.ToLower(weekday.String())
+			if day == name || day == name[:3] {
+				ahead := (int(weekday) - int(today.Weekday()) + 7) % 7
+				if ahead == 0 {
+					ahead = 7
+				}
+				due = today.AddDate(0, 0, ahead)
+			}
+		}
+	}
+	if due.IsZero() {
+		return "", fmt.Errorf("cannot read due date %q, use 2006-01-02, today, tomorrow, friday, next week or an offset like 3d", value)
+	}
+	return due.Format("2006-01-02"), nil
+}
+
+// resolveCycle finds a team's cycle by current, next, its number or its
+// name.
+func resolveCycle(ctx context.Context, teamID string, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if isUUID(value) {
+		return value, nil
+	}
+	var filter client.CycleFilter
+	switch strings.ToLower(value) {
+	case "current", "active":
+		filter = client.CycleFilter{"isActive": map[string]any{"eq": true}}
+	case "next":
+		filter = client.CycleFilter{"isNext": map[string]any{"eq": true}}
+	default:
+		if n, err := strconv.Atoi(strings.TrimPrefix(value, "#")); err == nil {
+			filter = client.CycleFilter{"number": map[string]any{"eq": n}}
+		} else {
+			filter = client.CycleFilter{"name": map[string]any{"eqIgnoreCase": value}}
+		}
+	}
+	cycle, err := linearClient.FindCycle(ctx, teamID, filter)
+	if err != nil {
+		return "", err
+	}
+	if cycle == nil {
+		return "", fmt.Errorf("no cycle matches %q", value)
+	}
+	return string(cycle.ID), nil
+}
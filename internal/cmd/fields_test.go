@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/junipery17/lineartui/internal/client"
+	"github.com/shurcooL/graphql"
+)
+
+func TestParseDueDate(t *testing.T) {
+	// A Wednesday.
+	now := time.Date(2026, 10, 14, 15, 30, 0, 0, time.UTC)
+	tests := []struct {
+		value, want string
+	}{
+		{"2026-12-24", "2026-12-24"},
+		{"today", "2026-10-14"},
+		{"Tomorrow", "2026-10-15"},
+		{"friday", "2026-10-16"},
+		{"fri", "2026-10-16"},
+		// "next friday" is the coming Friday too, not the one after.
+		{"next friday", "2026-10-16"},
+		{"next  Friday", "2026-10-16"},
+		{"monday", "2026-10-19"},
+		// The same weekday means a week from today.
+		{"wednesday", "2026-10-21"},
+		{"next week", "2026-10-21"},
+		{"next month", "2026-11-14"},
+		{"3d", "2026-10-17"},
+		{"0d", "2026-10-14"},
+		{"2w", "2026-10-28"},
+		{"1m", "2026-11-14"},
+		{"in 10 days", "2026-10-24"},
+		{"in 1 week", "2026-10-21"},
+	}
+	for _, tt := range tests {
+		got, err := parseDueDate(tt.value, now)
+		if err != nil {
+			t.Errorf("parseDueDate(%q) error: %v", tt.value, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseDueDate(%q) = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+
+	for _, value := range []string{"", "someday", "next", "fr", "-3d", "3y", "2026-13-01", "in days"} {
+		if got, err := parseDueDate(value, now); err == nil {
+			t.Errorf("parseDueDate(%q) = %s, want an error", value, got)
+		}
+	}
+}
+
+// estimationClient answers GetTeamEstimation with a fixed scale.
+type estimationClient struct {
+	client.Client
+	estimation client.TeamEstimation
+}
+
+func (c *estimationClient) GetTeamEstimation(ctx context.Context, teamID string) (*client.TeamEstimation, error) {
+	return &c.estimation, nil
+}
+
+func TestParseEstimate(t *testing.T) {
+	tests := []struct {
+		kind           string
+		extended, zero bool
+		value          string
+		want           int
+		err            string
+	}{
+		{kind: "fibonacci", value: "5", want: 5},
+		{kind: "fibonacci", value: "4", err: `estimate "4" is not on the team's fibonacci scale: 1, 2, 3, 5, 8`},
+		{kind: "fibonacci", value: "13", err: `estimate "13" is not on the team's fibonacci scale: 1, 2, 3, 5, 8`},
+		{kind: "fibonacci", extended: true, value: "13", want: 13},
+		{kind: "fibonacci", value: "0", err: `estimate "0" is not on the team's fibonacci scale: 1, 2, 3, 5, 8`},
+		{kind: "fibonacci", zero: true, value: "0", want: 0},
+		{kind: "exponential", extended: true, value: "64", want: 64},
+		{kind: "linear", value: " 4 ", want: 4},
+		{kind: "linear", value: "big", err: `estimate "big" is not on the team's linear scale: 1, 2, 3, 4, 5`},
+		{kind: "tShirt", value: "m", want: 3},
+		{kind: "tShirt", value: "XL", want: 8},
+		{kind: "tShirt", value: "5", want: 5},
+		{kind: "tShirt", value: "XXL", err: `estimate "XXL" is not on the team's tShirt scale: XS (1), S (2), M (3), L (5), XL (8)`},
+		{kind: "tShirt", extended: true, value: "XXXL", want: 21},
+		{kind: "tShirt", zero: true, value: "4", err: `estimate "4" is not on the team's tShirt scale: 0, XS (1), S (2), M (3), L (5), XL (8)`},
+		{kind: "notUsed", value: "1", err: "the team does not use estimates"},
+	}
+
+	saved := linearClient
+	defer func() { linearClient = saved }()
+	for _, tt := range tests {
+		linearClient = &estimationClient{estimation: client.TeamEstimation{
+			Type:      graphql.String(tt.kind),
+			Extended:  graphql.Boolean(tt.extended),
+			AllowZero: graphql.Boolean(tt.zero),
+		}}
+		got, err := parseEstimate(context.Background(), "team", tt.value)
+		if tt.err != "" {
+			if err == nil || err.Error() != tt.err {
+				t.Errorf("%s extended=%t zero=%t: parseEstimate(%q) error = %v, want %s", tt.kind, tt.extended, tt.zero, tt.value, err, tt.err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("%s extended=%t zero=%t: parseEstimate(%q) = %d, %v, want %d", tt.kind, tt.extended, tt.zero, tt.value, got, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,323 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/junipery17/lineartui/internal/client"
+	"github.com/spf13/cobra"
+)
+
+var issuesGraphCmd = &cobra.Command{
+	Use:   "graph",
+	Short: "Export the blocking graph of a team or project",
+	Long: `Export which issues block which as a Graphviz DOT or Mermaid graph,
+with nodes colored by state. Issues outside the team or project that block
+or are blocked by issues in it are drawn dashed. Dependency cycles are
+reported on stderr and drawn in red.
+
+  lineartui issues graph --team ENG | dot -Tsvg > deps.svg
+  lineartui issues graph --project "Q4 Infra" --format mermaid`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		format, _ := cmd.Flags().GetString("format")
+		if format != "dot" && format != "mermaid" {
+			return fmt.Errorf("unknown graph format %q, use dot or mermaid", format)
+		}
+
+		var issueFilter client.IssueFilter
+		if project, _ := cmd.Flags().GetString("project"); project != "" {
+			projectID, err := resolveProjectID(ctx, project)
+			if err != nil {
+				return err
+			}
+			issueFilter = client.IssueFilter{"project": map[string]any{"id": map[string]any{"eq": projectID}}}
+		} else {
+			team, _ := cmd.Flags().GetString("team")
+			teamID, err := resolveTeamID(ctx, team)
+			if err != nil {
+				return err
+			}
+			issueFilter = client.IssueFilter{"team": map[string]any{"id": map[string]any{"eq": teamID}}}
+		}
+
+		issues, err := linearClient.ListIssueBlockers(ctx, issueFilter)
+		if err != nil {
+			return err
+		}
+		graph := buildBlockingGraph(issues)
+		for _, cycle := range graph.Cycles {
+			fmt.Fprintf(os.Stderr, "warning: dependency cycle: %s\n", strings.Join(append(cycle, cycle[0]), " → "))
+		}
+
+		switch {
+		case outputFormat == outputJSON:
+			return printJSON(graph)
+		case format == "mermaid":
+			printMermaid(graph)
+		default:
+			printDOT(graph)
+		}
+		return nil
+	},
+}
+
+type graphNode struct {
+	Identifier string `json:"identifier"`
+	Title      string `json:"title"`
+	State      string `json:"state"`
+	StateType  string `json:"stateType"`
+	// External nodes are outside the team or project being drawn.
+	External bool `json:"external"`
+}
+
+type graphEdge struct {
+	From    string `json:"from"`
+	To      string `json:"to"`
+	InCycle bool   `json:"inCycle"`
+}
+
+type blockingGraph struct {
+	Nodes  []graphNode `json:"nodes"`
+	Edges  []graphEdge `json:"edges"`
+	Cycles [][]string  `json:"cycles"`
+}
+
+// buildBlockingGraph keeps only "blocks" relations. Every issue in scope is
+// a node, even without edges, so the graph shows what is unblocked. Issues
+// out of scope are added as external nodes when they block or are blocked
+// by one in scope.
+func buildBlockingGraph(issues []client.IssueBlockers) *blockingGraph {
+	graph := &blockingGraph{Cycles: [][]string{}}
+	seen := make(map[string]bool)
+	addNode := func(node graphNode) {
+		if !seen[node.Identifier] {
+			seen[node.Identifier] = true
+			graph.Nodes = append(graph.Nodes, node)
+		}
+	}
+	for _, issue := range issues {
+		addNode(graphNode{
+			Identifier: string(issue.Identifier),
+			Title:      string(issue.Title),
+			State:      string(issue.State.Name),
+			StateType:  string(issue.State.Type),
+		})
+	}
+	inScope := make(map[string]bool, len(issues))
+	for _, issue := range issues {
+		inScope[string(issue.Identifier)] = true
+	}
+	addExternal := func(issue client.IssueRef) {
+		addNode(graphNode{
+			Identifier: string(issue.Identifier),
+			Title:      string(issue.Title),
+			State:      string(issue.State.Name),
+			StateType:  string(issue.State.Type),
+			External:   true,
+		})
+	}
+	for _, issue := range issues {
+		for _, relation := range issue.Relations.Nodes {
+			if relation.Type != "blocks" {
+				continue
+			}
+			addExternal(relation.RelatedIssue)
+			graph.Edges = append(graph.Edges, graphEdge{From: string(issue.Identifier), To: string(relation.RelatedIssue.Identifier)})
+		}
+		// Blockers in scope already drew this edge from their own side.
+		for _, relation := range issue.InverseRelations.Nodes {
+			blocker := relation.Issue
+			if relation.Type != "blocks" || inScope[string(blocker.Identifier)] {
+				continue
+			}
+			addExternal(blocker)
+			graph.Edges = append(graph.Edges, graphEdge{From: string(blocker.Identifier), To: string(issue.Identifier)})
+		}
+	}
+
+	component := make(map[string]int)
+	for i, scc := range stronglyConnected(graph.Nodes, graph.Edges) {
+		if len(scc) > 1 {
+			graph.Cycles = append(graph.Cycles, scc)
+		}
+		for _, id := range scc {
+			component[id] = i
+		}
+	}
+	for i, edge := range graph.Edges {
+		if edge.From == edge.To {
+			graph.Cycles = append(graph.Cycles, []string{edge.From})
+		}
+		if component[edge.From] == component[edge.To] {
+			graph.Edges[i].InCycle = true
+		}
+	}
+	return graph
+}
+
+// stronglyConnected runs Tarjan's algorithm. Any component with more than
+// one issue contains a dependency cycle.
+func stronglyConnected(nodes []graphNode, edges []graphEdge) [][]string {
+	next := make(map[string][]string)
+	for _, edge := range edges {
+		next[edge.From] = append(next[edge.From], edge.To)
+	}
+
+	index := make(map[string]int)
+	low := make(map[string]int)
+	onStack := make(map[string]bool)
+	var stack []string
+	var components [][]string
+	counter := 0
+
+	var visit func(id string)
+	visit = func(id string) {
+		index[id] = counter
+		low[id] = counter
+		counter++
+		stack = append(stack, id)
+		onStack[id] = true
+		for _, to := range next[id] {
+			if _, ok := index[to]; !ok {
+				visit(to)
+				low[id] = min(low[id], low[to])
+			} else if onStack[to] {
+				low[id] = min(low[id], index[to])
+			}
+		}
+		if low[id] == index[id] {
+			var scc []string
+			for {
+				top := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[top] = false
+				scc = append(scc, top)
+				if top == id {
+					break
+				}
+			}
+			sort.Slice(scc, func(i, j int) bool {
+				return compareIdentifiers(scc[i], scc[j]) < 0
+			})
+			components = append(components, scc)
+		}
+	}
+	for _, node := range nodes {
+		if _, ok := index[node.Identifier]; !ok {
+			visit(node.Identifier)
+		}
+	}
+	return components
+}
+
+func compareIdentifiers(a, b string) int {
+	teamA, _, _ := strings.Cut(a, "-")
+	teamB, _, _ := strings.Cut(b, "-")
+	if teamA != teamB {
+		return strings.Compare(teamA, teamB)
+	}
+	return identifierNumber(a) - identifierNumber(b)
+}
+
+// stateColors follow the colors Linear uses for each state type.
+var stateColors = map[string]string{
+	"triage":    "#fc7840",
+	"backlog":   "#bec2c8",
+	"unstarted": "#e2e2e2",
+	"started":   "#f2c94c",
+	"completed": "#5e6ad2",
+	"canceled":  "#95a2b3",
+}
+
+func stateColor(stateType string) string {
+	if color, ok := stateColors[stateType]; ok {
+		return color
+	}
+	return "#ffffff"
+}
+
+func printDOT(graph *blockingGraph) {
+	fmt.Println("digraph blocking {")
+	fmt.Println("  rankdir=LR;")
+	fmt.Println(`  node [shape=box, style="rounded,filled", fontname="Helvetica"];`)
+	for _, node := range graph.Nodes {
+		style := `"rounded,filled"`
+		if node.External {
+			style = `"rounded,filled,dashed"`
+		}
+		label := fmt.Sprintf("%s\\n%s\\n[%s]", node.Identifier, dotEscape(truncate(node.Title, 40)), dotEscape(node.State))
+		fmt.Printf("  %q [label=\"%s\", fillcolor=%q, style=%s];\n", node.Identifier, label, stateColor(node.StateType), style)
+	}
+	for _, edge := range graph.Edges {
+		attrs := ""
+		if edge.InCycle {
+			attrs = " [color=red, penwidth=2]"
+		}
+		fmt.Printf("  %q -> %q%s;\n", edge.From, edge.To, attrs)
+	}
+	fmt.Println("}")
+}
+
+func dotEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s)
+}
+
+var mermaidIDChars = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+func printMermaid(graph *blockingGraph) {
+	fmt.Println("graph LR")
+	for _, node := range graph.Nodes {
+		id := mermaidIDChars.ReplaceAllString(node.Identifier, "_")
+		label := fmt.Sprintf("%s<br/>%s<br/>[%s]", node.Identifier, mermaidEscape(truncate(node.Title, 40)), mermaidEscape(node.State))
+		fmt.Printf("  %s[\"%s\"]:::%s\n", id, label, mermaidClass(node))
+	}
+	var cycleLinks []string
+	for i, edge := range graph.Edges {
+		from := mermaidIDChars.ReplaceAllString(edge.From, "_")
+		to := mermaidIDChars.ReplaceAllString(edge.To, "_")
+		fmt.Printf("  %s --> %s\n", from, to)
+		if edge.InCycle {
+			cycleLinks = append(cycleLinks, fmt.Sprint(i))
+		}
+	}
+	types := make([]string, 0, len(stateColors))
+	for stateType := range stateColors {
+		types = append(types, stateType)
+	}
+	sort.Strings(types)
+	for _, stateType := range types {
+		fmt.Printf("  classDef %s fill:%s\n", stateType, stateColors[stateType])
+		fmt.Printf("  classDef %s_external fill:%s,stroke-dasharray:5 5\n", stateType, stateColors[stateType])
+	}
+	if len(cycleLinks) > 0 {
+		fmt.Printf("  linkStyle %s stroke:red,stroke-width:2px\n", strings.Join(cycleLinks, ","))
+	}
+}
+
+func mermaidClass(node graphNode) string {
+	class := node.StateType
+	if _, ok := stateColors[class]; !ok {
+		class = "unstarted"
+	}
+	if node.External {
+		class += "_external"
+	}
+	return class
+}
+
+func mermaidEscape(s string) string {
+	return strings.NewReplacer(`"`, "#quot;", "<", "#lt;", ">", "#gt;").Replace(s)
+}
+
+func init() {
+	issuesCmd.AddCommand(issuesGraphCmd)
+	issuesGraphCmd.Flags().StringP("team", "t", "", "Team name or ID to graph")
+	issuesGraphCmd.Flags().StringP("project", "p", "", "Project name or ID to graph instead of a team")
+	issuesGraphCmd.Flags().String("format", "dot", "Graph format: dot or mermaid")
+	issuesGraphCmd.MarkFlagsMutuallyExclusive("team", "project")
+}
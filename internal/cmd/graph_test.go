@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/junipery17/lineartui/internal/client"
+)
+
+func TestBuildBlockingGraph(t *testing.T) {
+	// ENG-1 blocks ENG-2 and OPS-9; OPS-7 blocks ENG-2. ENG-2 sees both of
+	// its blockers as inverse relations.
+	var issues []client.IssueBlockers
+	err := json.Unmarshal([]byte(`[
+		{"identifier": "ENG-1", "relations": {"nodes": [
+			{"type": "blocks", "relatedIssue": {"identifier": "ENG-2"}},
+			{"type": "blocks", "relatedIssue": {"identifier": "OPS-9"}},
+			{"type": "related", "relatedIssue": {"identifier": "OPS-8"}}
+		]}},
+		{"identifier": "ENG-2", "inverseRelations": {"nodes": [
+			{"type": "blocks", "issue": {"identifier": "ENG-1"}},
+			{"type": "blocks", "issue": {"identifier": "OPS-7"}},
+			{"type": "duplicate", "issue": {"identifier": "OPS-6"}}
+		]}}
+	]`), &issues)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	graph := buildBlockingGraph(issues)
+	var nodes []string
+	for _, node := range graph.Nodes {
+		if node.External {
+			nodes = append(nodes, node.Identifier+" (external)")
+		} else {
+			nodes = append(nodes, node.Identifier)
+		}
+	}
+	if want := []string{"ENG-1", "ENG-2", "OPS-9 (external)", "OPS-7 (external)"}; !reflect.DeepEqual(nodes, want) {
+		t.Errorf("nodes = %q, want %q", nodes, want)
+	}
+	want := []graphEdge{{From: "ENG-1", To: "ENG-2"}, {From: "ENG-1", To: "OPS-9"}, {From: "OPS-7", To: "ENG-2"}}
+	if !reflect.DeepEqual(graph.Edges, want) {
+		t.Errorf("edges = %+v, want %+v", graph.Edges, want)
+	}
+}
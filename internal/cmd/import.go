@@ -0,0 +1,691 @@
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/junipery17/lineartui/internal/client"
+	"github.com/shurcooL/graphql"
+	"github.com/spf13/cobra"
+	"go.yaml.in/yaml/v3"
+)
+
+// importFields are the issue fields a column can be mapped to. id is the
+// row's external ID, which the ledger remembers.
+var importFields = []string{"id", "title", "description", "team", "state", "assignee", "priority", "labels", "estimate", "due", "project", "cycle"}
+
+// importAliases are column names recognised without a mapping besides the
+// field names themselves.
+var importAliases = map[string]string{
+	"externalid": "id",
+	"key":        "id",
+	"issuekey":   "id",
+	"summary":    "title",
+	"body":       "description",
+	"status":     "state",
+	"owner":      "assignee",
+	"label":      "labels",
+	"tags":       "labels",
+	"points":     "estimate",
+	"duedate":    "due",
+}
+
+var issuesImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Create or update issues from a CSV, JSON or YAML file",
+	Long: `Create issues from the rows of a CSV file, or the objects of a JSON or
+YAML list. Columns named after a field (` + strings.Join(importFields, ", ") + `)
+are used as is; others are mapped with --map or a mapping file:
+
+  lineartui issues import q4.csv --map title=Summary,labels=Tags,id="Issue key"
+
+Teams, users, labels, states, projects and cycles are given by name, labels
+as a comma-separated list or in repeated columns of the same name. Rows without a team go to --team or the default
+team.
+
+The Linear issue created for each external ID (the id field) is recorded in
+a ledger, by default next to the file, so importing the file again updates
+those issues instead of creating duplicates. Empty cells leave a field as it
+is. Run with --dry-run first to check every row resolves.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		file := args[0]
+		format, _ := cmd.Flags().GetString("format")
+		records, columns, err := readImportFile(file, format)
+		if err != nil {
+			return err
+		}
+		mapping, err := importMapping(cmd, columns)
+		if err != nil {
+			return err
+		}
+		if _, ok := mapping["title"]; !ok {
+			return fmt.Errorf("no column is mapped to title, use --map title=<column>")
+		}
+
+		ledgerPath, _ := cmd.Flags().GetString("ledger")
+		if ledgerPath == "" {
+			ledgerPath = strings.TrimSuffix(file, filepath.Ext(file)) + ".ledger.json"
+		}
+		ledger, err := loadImportLedger(ledgerPath)
+		if err != nil {
+			return err
+		}
+
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		team, _ := cmd.Flags().GetString("team")
+		resolver := newImportResolver(team)
+		rows, err := resolveImportRows(ctx, resolver, records, mapping, ledger)
+		if err != nil {
+			return err
+		}
+		if _, ok := mapping["id"]; !ok {
+			fmt.Fprintln(os.Stderr, "warning: no id column, importing again will create the issues again")
+		}
+		if dryRun {
+			return printImportPlan(rows, resolver.newLabels)
+		}
+		return runImport(ctx, resolver, rows, ledger)
+	},
+}
+
+// importRecord is one row of the file, column → value. line is the CSV line
+// or the position in a JSON or YAML list.
+type importRecord struct {
+	line   int
+	values map[string]string
+}
+
+// readImportFile returns the records of file along with the column names in
+// the order first seen.
+func readImportFile(file string, format string) ([]importRecord, []string, error) {
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(file)), ".")
+	}
+	raw, err := os.ReadFile(file)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var columns []string
+	addColumn := func(name string) {
+		if !slices.Contains(columns, name) {
+			columns = append(columns, name)
+		}
+	}
+	var records []importRecord
+	switch format {
+	case "csv":
+		// Spreadsheet exports often start with a byte order mark.
+		reader := csv.NewReader(strings.NewReader(strings.TrimPrefix(string(raw), "\ufeff")))
+		reader.FieldsPerRecord = -1
+		rows, err := reader.ReadAll()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read %s: %w", file, err)
+		}
+		if len(rows) == 0 {
+			return nil, nil, fmt.Errorf("%s is empty", file)
+		}
+		header := make([]string, len(rows[0]))
+		for i, name := range rows[0] {
+			header[i] = strings.TrimSpace(name)
+			addColumn(header[i])
+		}
+		for n, row := range rows[1:] {
+			record := importRecord{line: n + 2, values: make(map[string]string)}
+			for i, value := range row {
+				if i >= len(header) {
+					break
+				}
+				// Repeated columns, such as the Labels columns of a Jira
+				// export, are joined into one comma-separated value.
+				name := header[i]
+				if prev := record.values[name]; prev != "" {
+					if strings.TrimSpace(value) != "" {
+						record.values[name] = prev + "," + value
+					}
+					continue
+				}
+				record.values[name] = value
+			}
+			records = append(records, record)
+		}
+	case "json", "yaml", "yml":
+		// YAML is a superset of JSON, so one decoder reads both.
+		var items []map[string]any
+		if err := yaml.Unmarshal(raw, &items); err != nil {
+			return nil, nil, fmt.Errorf("failed to read %s, expected a list of objects: %w", file, err)
+		}
+		for n, item := range items {
+			record := importRecord{line: n + 1, values: make(map[string]string)}
+			keys := make([]string, 0, len(item))
+			for key := range item {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				addColumn(key)
+				record.values[key] = importValue(item[key])
+			}
+			records = append(records, record)
+		}
+	default:
+		return nil, nil, fmt.Errorf("unknown import format %q, use csv, json or yaml", format)
+	}
+	return records, columns, nil
+}
+
+// importValue flattens a JSON or YAML value into a cell. Lists become
+// comma-separated, as labels are written in a CSV.
+func importValue(value any) string {
+	switch value := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case []any:
+		parts := make([]string, 0, len(value))
+		for _, item := range value {
+			parts = append(parts, importValue(item))
+		}
+		return strings.Join(parts, ",")
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// importMapping returns field → column from the columns named after a
+// field, overridden by the mapping file and then by --map.
+func importMapping(cmd *cobra.Command, columns []string) (map[string]string, error) {
+	mapping := make(map[string]string)
+	for _, column := range columns {
+		key := strings.NewReplacer(" ", "", "_", "", "-", "").Replace(strings.ToLower(column))
+		if field, ok := importAliases[key]; ok {
+			key = field
+		}
+		if _, taken := mapping[key]; slices.Contains(importFields, key) && !taken {
+			mapping[key] = column
+		}
+	}
+
+	pairs := map[string]string{}
+	if path, _ := cmd.Flags().GetString("map-file"); path != "" {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := yaml.Unmarshal(raw, &pairs); err != nil {
+			return nil, fmt.Errorf("failed to read mapping file %s: %w", path, err)
+		}
+	}
+	flagPairs, _ := cmd.Flags().GetStringSlice("map")
+	for _, pair := range flagPairs {
+		field, column, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("--map takes field=column, got %q", pair)
+		}
+		pairs[strings.TrimSpace(field)] = strings.Trim(strings.TrimSpace(column), `"`)
+	}
+	for field, column := range pairs {
+		field = strings.ToLower(field)
+		if !slices.Contains(importFields, field) {
+			return nil, fmt.Errorf("unknown field %q, map one of %s", field, strings.Join(importFields, ", "))
+		}
+		if !slices.Contains(columns, column) {
+			return nil, fmt.Errorf("the file has no column %q for %s", column, field)
+		}
+		mapping[field] = column
+	}
+	return mapping, nil
+}
+
+// importLedger maps external IDs to the issues created for them.
+type importLedger struct {
+	path   string
+	Issues map[string]ledgerEntry `json:"issues"`
+}
+
+type ledgerEntry struct {
+	ID         string    `json:"id"`
+	Identifier string    `json:"identifier"`
+	ImportedAt time.Time `json:"importedAt"`
+}
+
+func loadImportLedger(path string) (*importLedger, error) {
+	ledger := &importLedger{path: path, Issues: make(map[string]ledgerEntry)}
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return ledger, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(raw, ledger); err != nil {
+		return nil, fmt.Errorf("failed to read ledger %s: %w", path, err)
+	}
+	if ledger.Issues == nil {
+		ledger.Issues = make(map[string]ledgerEntry)
+	}
+	return ledger, nil
+}
+
+// save writes the ledger through a temporary file, so an interrupted
+// import never leaves it half written.
+func (l *importLedger) save() error {
+	raw, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := l.path + ".tmp"
+	if err := os.WriteFile(tmp, append(raw, '\n'), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, l.path)
+}
+
+// importResolver turns names into IDs, asking Linear once per name.
+type importResolver struct {
+	defaultTeam string
+	teams       map[string]string
+	users       map[string]string
+	projects    map[string]string
+	labels      map[string]string
+	states      map[string][]client.WorkflowStateData
+	cycles      map[string]string
+	estimates   map[string]int
+	// newLabels are labels not found in the workspace. They are created
+	// only once every row has resolved, and never in a dry run.
+	newLabels []string
+}
+
+func newImportResolver(defaultTeam string) *importResolver {
+	return &importResolver{
+		defaultTeam: defaultTeam,
+		teams:       make(map[string]string),
+		users:       make(map[string]string),
+		projects:    make(map[string]string),
+		labels:      make(map[string]string),
+		states:      make(map[string][]client.WorkflowStateData),
+		cycles:      make(map[string]string),
+		estimates:   make(map[string]int),
+	}
+}
+
+func (r *importResolver) team(ctx context.Context, name string) (string, error) {
+	if name == "" {
+		name = r.defaultTeam
+	}
+	if id, ok := r.teams[name]; ok {
+		return id, nil
+	}
+	id, err := resolveTeamID(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	r.teams[name] = id
+	return id, nil
+}
+
+func (r *importResolver) user(ctx context.Context, name string) (string, error) {
+	key := strings.ToLower(name)
+	if id, ok := r.users[key]; ok {
+		return id, nil
+	}
+	id, err := resolveAssignee(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	r.users[key] = id
+	return id, nil
+}
+
+func (r *importResolver) project(ctx context.Context, name string) (string, error) {
+	key := strings.ToLower(name)
+	if id, ok := r.projects[key]; ok {
+		return id, nil
+	}
+	id, err := resolveProjectID(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	r.projects[key] = id
+	return id, nil
+}
+
+func (r *importResolver) state(ctx context.Context, teamID string, name string) (string, error) {
+	if _, ok := r.states[teamID]; !ok {
+		states, err := linearClient.GetWorkflowStates(ctx, teamID)
+		if err != nil {
+			return "", err
+		}
+		r.states[teamID] = states
+	}
+	state, err := matchState(r.states[teamID], name)
+	if err != nil {
+		return "", err
+	}
+	return string(state.ID), nil
+}
+
+func (r *importResolver) cycle(ctx context.Context, teamID string, value string) (string, error) {
+	key := teamID + "/" + strings.ToLower(value)
+	if id, ok := r.cycles[key]; ok {
+		return id, nil
+	}
+	id, err := resolveCycle(ctx, teamID, value)
+	if err != nil {
+		return "", err
+	}
+	r.cycles[key] = id
+	return id, nil
+}
+
+func (r *importResolver) estimate(ctx context.Context, teamID string, value string) (int, error) {
+	key := teamID + "/" + strings.ToLower(value)
+	if n, ok := r.estimates[key]; ok {
+		return n, nil
+	}
+	n, err := parseEstimate(ctx, teamID, value)
+	if err != nil {
+		return 0, err
+	}
+	r.estimates[key] = n
+	return n, nil
+}
+
+// label looks a label up by name, noting it in newLabels when it does not
+// exist yet. A failed lookup is an error rather than a missing label, so it
+// never leads to a duplicate.
+func (r *importResolver) label(ctx context.Context, name string) error {
+	key := strings.ToLower(name)
+	if _, ok := r.labels[key]; ok {
+		return nil
+	}
+	id, err := linearClient.SearchLabel(ctx, name)
+	if err != nil {
+		return err
+	}
+	if id == "" {
+		r.newLabels = append(r.newLabels, name)
+	}
+	r.labels[key] = id
+	return nil
+}
+
+// createLabels creates the labels that label found missing.
+func (r *importResolver) createLabels(ctx context.Context) error {
+	for _, name := range r.newLabels {
+		id, err := linearClient.CreateNewLabel(ctx, name)
+		if err != nil {
+			return err
+		}
+		r.labels[strings.ToLower(name)] = id
+	}
+	return nil
+}
+
+// setLabels fills in the label IDs of row once every label exists.
+func (r *importResolver) setLabels(row *importRow) {
+	if row.labels == nil {
+		return
+	}
+	ids := []string{}
+	for _, name := range row.labels {
+		id := r.labels[strings.ToLower(name)]
+		if id != "" && !slices.Contains(ids, id) {
+			ids = append(ids, id)
+			row.create.LabelIDs = append(row.create.LabelIDs, graphql.String(id))
+		}
+	}
+	row.update["labelIds"] = ids
+}
+
+// importRow is one record with every name resolved.
+type importRow struct {
+	line       int
+	externalID string
+	title      string
+	labels     []string
+	existing   *ledgerEntry
+	create     client.IssueCreateInput
+	update     client.IssueUpdateInput
+}
+
+// resolveImportRows resolves every row before anything is written, and
+// reports all rows that fail rather than only the first.
+func resolveImportRows(ctx context.Context, resolver *importResolver, records []importRecord, mapping map[string]string, ledger *importLedger) ([]importRow, error) {
+	var rows []importRow
+	var problems []string
+	seen := make(map[string]int)
+	for _, record := range records {
+		if isBlankRecord(record.values) {
+			continue
+		}
+		line := record.line
+		get := func(field string) string {
+			column, ok := mapping[field]
+			if !ok {
+				return ""
+			}
+			return strings.TrimSpace(record.values[column])
+		}
+		row, err := resolveImportRow(ctx, resolver, get)
+		if err == nil && row.externalID != "" {
+			if first, dup := seen[row.externalID]; dup {
+				err = fmt.Errorf("id %q was already used on row %d", row.externalID, first)
+			}
+			seen[row.externalID] = line
+			if entry, ok := ledger.Issues[row.externalID]; ok {
+				row.existing = &entry
+			}
+		}
+		if err == nil && row.existing == nil && row.title == "" {
+			err = fmt.Errorf("a title is required to create an issue")
+		}
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("row %d: %s", line, err))
+			continue
+		}
+		row.line = line
+		rows = append(rows, *row)
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("%d of %d rows cannot be imported:\n  %s", len(problems), len(records), strings.Join(problems, "\n  "))
+	}
+	return rows, nil
+}
+
+func isBlankRecord(record map[string]string) bool {
+	for _, value := range record {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// resolveImportRow builds both the create and the update input for a row;
+// which one is sent depends on the ledger. Empty cells are left out of
+// both, so an update never clears a field.
+func resolveImportRow(ctx context.Context, resolver *importResolver, get func(field string) string) (*importRow, error) {
+	row := &importRow{externalID: get("id"), title: get("title"), update: client.IssueUpdateInput{}}
+	teamID, err := resolver.team(ctx, get("team"))
+	if err != nil {
+		return nil, err
+	}
+	row.create.TeamID = graphql.String(teamID)
+	if row.title != "" {
+		row.create.Title = graphql.String(row.title)
+		row.update["title"] = row.title
+	}
+	if value := get("description"); value != "" {
+		row.create.Description = graphql.String(value)
+		row.update["description"] = value
+	}
+	if value := get("state"); value != "" {
+		id, err := resolver.state(ctx, teamID, value)
+		if err != nil {
+			return nil, err
+		}
+		row.create.StateID = graphql.String(id)
+		row.update["stateId"] = id
+	}
+	if value := get("assignee"); value != "" {
+		id, err := resolver.user(ctx, value)
+		if err != nil {
+			return nil, err
+		}
+		if id != "" {
+			row.create.AssigneeID = graphql.String(id)
+			row.update["assigneeId"] = id
+		}
+	}
+	if value := get("priority"); value != "" {
+		priority, err := parsePriority(value)
+		if err != nil {
+			return nil, err
+		}
+		row.create.Priority = graphql.NewInt(graphql.Int(priority))
+		row.update["priority"] = priority
+	}
+	if value := get("labels"); value != "" {
+		// IDs are filled in by setLabels, after missing labels exist.
+		row.labels = trimAll(strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ';' }))
+		for _, name := range row.labels {
+			if err := resolver.label(ctx, name); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if value := get("estimate"); value != "" {
+		estimate, err := resolver.estimate(ctx, teamID, value)
+		if err != nil {
+			return nil, err
+		}
+		row.create.Estimate = graphql.NewInt(graphql.Int(estimate))
+		row.update["estimate"] = estimate
+	}
+	if value := get("due"); value != "" {
+		due, err := parseDueDate(value, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		row.create.DueDate = graphql.String(due)
+		row.update["dueDate"] = due
+	}
+	if value := get("project"); value != "" {
+		id, err := resolver.project(ctx, value)
+		if err != nil {
+			return nil, err
+		}
+		row.create.ProjectID = graphql.String(id)
+		row.update["projectId"] = id
+	}
+	if value := get("cycle"); value != "" {
+		id, err := resolver.cycle(ctx, teamID, value)
+		if err != nil {
+			return nil, err
+		}
+		row.create.CycleID = graphql.String(id)
+		row.update["cycleId"] = id
+	}
+	return row, nil
+}
+
+func printImportPlan(rows []importRow, newLabels []string) error {
+	creates, updates := 0, 0
+	for _, row := range rows {
+		if row.existing != nil {
+			updates++
+			fmt.Printf("update  row %-4d %-10s %s\n", row.line, row.existing.Identifier, truncate(row.title, 60))
+		} else {
+			creates++
+			fmt.Printf("create  row %-4d %-10s %s\n", row.line, "", truncate(row.title, 60))
+		}
+	}
+	if len(newLabels) > 0 {
+		fmt.Printf("New labels: %s\n", strings.Join(newLabels, ", "))
+	}
+	fmt.Printf("Would create %d and update %d issues.\n", creates, updates)
+	return nil
+}
+
+// runImport creates the missing labels, then new issues one at a time,
+// saving the ledger after each so an interrupted import can simply be run
+// again, then sends the updates as one batch.
+func runImport(ctx context.Context, resolver *importResolver, rows []importRow, ledger *importLedger) error {
+	if err := resolver.createLabels(ctx); err != nil {
+		return err
+	}
+	for i := range rows {
+		resolver.setLabels(&rows[i])
+	}
+	bar := newProgressBar(len(rows))
+	failed, created, updatedCount := 0, 0, 0
+	var updates []client.IssueUpdate
+	var updated []importRow
+	for _, row := range rows {
+		if row.existing != nil {
+			updates = append(updates, client.IssueUpdate{IssueID: row.existing.ID, Input: row.update})
+			updated = append(updated, row)
+			continue
+		}
+		issue, err := linearClient.CreateIssue(ctx, row.create)
+		if err == nil && row.externalID != "" {
+			ledger.Issues[row.externalID] = ledgerEntry{ID: string(issue.ID), Identifier: string(issue.Identifier), ImportedAt: time.Now().UTC()}
+			err = ledger.save()
+		}
+		if err != nil {
+			bar.Printf(os.Stderr, "row %d: %s\n", row.line, err)
+			failed++
+		} else {
+			created++
+			bar.Printf(os.Stdout, "Created %s: %s\n", issue.Identifier, issue.Title)
+		}
+		bar.Increment()
+	}
+	for i, err := range linearClient.UpdateIssues(ctx, updates) {
+		row := updated[i]
+		if err != nil {
+			bar.Printf(os.Stderr, "row %d (%s): %s\n", row.line, row.existing.Identifier, err)
+			failed++
+		} else {
+			updatedCount++
+			bar.Printf(os.Stdout, "Updated %s: %s\n", row.existing.Identifier, row.title)
+		}
+		bar.Increment()
+	}
+	bar.Finish()
+
+	fmt.Printf("Created %d and updated %d issues.\n", created, updatedCount)
+	if failed > 0 {
+		return fmt.Errorf("%d of %d rows could not be imported", failed, len(rows))
+	}
+	return nil
+}
+
+func init() {
+	issuesCmd.AddCommand(issuesImportCmd)
+
+	flags := issuesImportCmd.Flags()
+	flags.StringSlice("map", nil, "Map fields to columns, e.g. title=Summary,labels=Tags")
+	flags.String("map-file", "", "YAML or JSON file mapping fields to columns")
+	flags.String("format", "", "File format: csv, json or yaml (default from the extension)")
+	flags.StringP("team", "t", "", "Team name or ID for rows without a team")
+	flags.String("ledger", "", "Ledger of imported issues (default <file>.ledger.json)")
+	flags.BoolP("dry-run", "n", false, "Resolve every row and print what would happen without writing")
+}
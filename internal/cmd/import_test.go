@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/junipery17/lineartui/internal/client"
+)
+
+func TestReadImportFileCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		csv     string
+		columns []string
+		want    []map[string]string
+	}{
+		{
+			name:    "plain",
+			csv:     "Summary,Status\nFix login,Todo\n",
+			columns: []string{"Summary", "Status"},
+			want:    []map[string]string{{"Summary": "Fix login", "Status": "Todo"}},
+		},
+		{
+			name:    "byte order mark and padded header",
+			csv:     "\ufeff Summary ,Status\nFix login,Todo\n",
+			columns: []string{"Summary", "Status"},
+			want:    []map[string]string{{"Summary": "Fix login", "Status": "Todo"}},
+		},
+		{
+			// Jira repeats the Labels column once per label.
+			name:    "repeated columns are joined",
+			csv:     "Summary,Labels,Labels,Status\nFix login,bug,ui,Todo\nTidy up,,chore,Done\nDocs,,,Backlog\n",
+			columns: []string{"Summary", "Labels", "Status"},
+			want: []map[string]string{
+				{"Summary": "Fix login", "Labels": "bug,ui", "Status": "Todo"},
+				{"Summary": "Tidy up", "Labels": "chore", "Status": "Done"},
+				{"Summary": "Docs", "Labels": "", "Status": "Backlog"},
+			},
+		},
+		{
+			name:    "short and long rows",
+			csv:     "Summary,Status\nFix login\nTidy up,Done,extra\n",
+			columns: []string{"Summary", "Status"},
+			want: []map[string]string{
+				{"Summary": "Fix login"},
+				{"Summary": "Tidy up", "Status": "Done"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		file := filepath.Join(t.TempDir(), "issues.csv")
+		if err := os.WriteFile(file, []byte(tt.csv), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		records, columns, err := readImportFile(file, "")
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(columns, tt.columns) {
+			t.Errorf("%s: columns = %q, want %q", tt.name, columns, tt.columns)
+		}
+		got := make([]map[string]string, len(records))
+		for i, record := range records {
+			got[i] = record.values
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: records = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// labelClient finds the labels in ids and fails to look up "flaky".
+type labelClient struct {
+	client.Client
+	ids map[string]string
+}
+
+func (c *labelClient) SearchLabel(ctx context.Context, name string) (string, error) {
+	if name == "flaky" {
+		return "", errors.New("Could not find label properly: timeout")
+	}
+	return c.ids[name], nil
+}
+
+func TestResolveImportRowsLabelLookupFails(t *testing.T) {
+	saved := linearClient
+	defer func() { linearClient = saved }()
+	linearClient = &labelClient{ids: map[string]string{"bug": "l1"}}
+
+	records := []importRecord{
+		{line: 2, values: map[string]string{"title": "Fix login", "labels": "bug,new"}},
+		{line: 3, values: map[string]string{"title": "Tidy up", "labels": "flaky"}},
+	}
+	mapping := map[string]string{"title": "title", "labels": "labels"}
+	resolver := newImportResolver("0d9f3a1e-2b4c-4d5e-8f60-718293a4b5c6")
+	_, err := resolveImportRows(context.Background(), resolver, records, mapping, &importLedger{})
+	want := "1 of 2 rows cannot be imported:\n  row 3: Could not find label properly: timeout"
+	if err == nil || err.Error() != want {
+		t.Errorf("error = %v, want %s", err, want)
+	}
+	// The label that failed to resolve must not be created as new.
+	if !reflect.DeepEqual(resolver.newLabels, []string{"new"}) {
+		t.Errorf("new labels = %q, want [new]", resolver.newLabels)
+	}
+}
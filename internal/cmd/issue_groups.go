@@ -0,0 +1,231 @@
+package cmd
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/junipery17/lineartui/internal/client"
+)
+
+var sortKeys = []string{"priority", "updated", "created", "state", "assignee", "title", "identifier", "estimate"}
+
+var groupKeys = []string{"state", "assignee", "label", "project", "cycle"}
+
+// stateTypeRank orders state types the way Linear's board does.
+var stateTypeRank = map[string]int{
+	"triage":    0,
+	"backlog":   1,
+	"unstarted": 2,
+	"started":   3,
+	"completed": 4,
+	"canceled":  5,
+}
+
+// sortIssues stably sorts by a comma-separated list of keys, each optionally
+// prefixed with - for descending order, e.g. "priority,-updated".
+func sortIssues(issues []client.IssueSummary, spec string) error {
+	type key struct {
+		name string
+		desc bool
+	}
+	var keys []key
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		k := key{name: strings.TrimPrefix(part, "-"), desc: strings.HasPrefix(part, "-")}
+		if !slices.Contains(sortKeys, k.name) {
+			return fmt.Errorf("unknown sort key %q, valid keys are: %s", k.name, strings.Join(sortKeys, ", "))
+		}
+		keys = append(keys, k)
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		for _, k := range keys {
+			c := compareIssues(&issues[i], &issues[j], k.name)
+			if k.desc {
+				c = -c
+			}
+			if c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	})
+	return nil
+}
+
+func compareIssues(a, b *client.IssueSummary, key string) int {
+	switch key {
+	case "priority":
+		return cmp.Compare(priorityRank(float64(a.Priority)), priorityRank(float64(b.Priority)))
+	case "updated":
+		return a.UpdatedAt.Compare(b.UpdatedAt)
+	case "created":
+		return a.CreatedAt.Compare(b.CreatedAt)
+	case "state":
+		if c := cmp.Compare(stateTypeRank[string(a.State.Type)], stateTypeRank[string(b.State.Type)]); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.State.Name, b.State.Name)
+	case "assignee":
+		return cmp.Compare(assigneeName(a), assigneeName(b))
+	case "title":
+		return cmp.Compare(strings.ToLower(string(a.Title)), strings.ToLower(string(b.Title)))
+	case "identifier":
+		if c := cmp.Compare(a.Team.Key, b.Team.Key); c != 0 {
+			return c
+		}
+		return cmp.Compare(identifierNumber(string(a.Identifier)), identifierNumber(string(b.Identifier)))
+	case "estimate":
+		return cmp.Compare(estimateValue(a), estimateValue(b))
+	}
+	return 0
+}
+
+// priorityRank puts "No priority" (0) after Low (4), as Linear does.
+func priorityRank(priority float64) float64 {
+	if priority == 0 {
+		return 5
+	}
+	return priority
+}
+
+func identifierNumber(identifier string) int {
+	_, number, _ := strings.Cut(identifier, "-")
+	n, _ := strconv.Atoi(number)
+	return n
+}
+
+func assigneeName(issue *client.IssueSummary) string {
+	if issue.Assignee == nil {
+		return ""
+	}
+	return string(issue.Assignee.Name)
+}
+
+func estimateValue(issue *client.IssueSummary) float64 {
+	if issue.Estimate == nil {
+		return 0
+	}
+	return float64(*issue.Estimate)
+}
+
+type issueGroup struct {
+	Key     string                `json:"key"`
+	Count   int                   `json:"count"`
+	Summary listSummary           `json:"summary"`
+	Issues  []client.IssueSummary `json:"issues"`
+}
+
+// groupIssues buckets issues by field, keeping the current order inside each
+// bucket. An issue with several labels appears under each of them.
+func groupIssues(issues []client.IssueSummary, field string) ([]issueGroup, error) {
+	if !slices.Contains(groupKeys, field) {
+		return nil, fmt.Errorf("unknown group %q, valid groups are: %s", field, strings.Join(groupKeys, ", "))
+	}
+
+	index := make(map[string]int)
+	var groups []issueGroup
+	rank := make(map[string]int)
+	for _, issue := range issues {
+		for _, key := range groupValues(issue, field) {
+			i, ok := index[key]
+			if !ok {
+				i = len(groups)
+				index[key] = i
+				groups = append(groups, issueGroup{Key: key})
+				if field == "state" {
+					rank[key] = stateTypeRank[string(issue.State.Type)]
+				}
+			}
+			groups[i].Issues = append(groups[i].Issues, issue)
+		}
+	}
+
+	for i := range groups {
+		groups[i].Count = len(groups[i].Issues)
+		groups[i].Summary = summarizeIssues(groups[i].Issues)
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		a, b := groups[i].Key, groups[j].Key
+		if field == "state" && rank[a] != rank[b] {
+			return rank[a] < rank[b]
+		}
+		// Issues without a value go last.
+		if noneA, noneB := strings.HasPrefix(a, "No "), strings.HasPrefix(b, "No "); noneA != noneB {
+			return noneB
+		}
+		return strings.ToLower(a) < strings.ToLower(b)
+	})
+	return groups, nil
+}
+
+func groupValues(issue client.IssueSummary, field string) []string {
+	switch field {
+	case "state":
+		return []string{string(issue.State.Name)}
+	case "assignee":
+		if issue.Assignee == nil {
+			return []string{"No assignee"}
+		}
+		return []string{string(issue.Assignee.Name)}
+	case "label":
+		if len(issue.Labels.Nodes) == 0 {
+			return []string{"No label"}
+		}
+		labels := make([]string, 0, len(issue.Labels.Nodes))
+		for _, label := range issue.Labels.Nodes {
+			labels = append(labels, string(label.Name))
+		}
+		return labels
+	case "project":
+		if issue.Project == nil {
+			return []string{"No project"}
+		}
+		return []string{string(issue.Project.Name)}
+	case "cycle":
+		if issue.Cycle == nil {
+			return []string{"No cycle"}
+		}
+		if issue.Cycle.Name != "" {
+			return []string{string(issue.Cycle.Name)}
+		}
+		return []string{fmt.Sprintf("Cycle %d", int(issue.Cycle.Number))}
+	}
+	return nil
+}
+
+type listSummary struct {
+	Total    int     `json:"total"`
+	Estimate float64 `json:"estimate"`
+	Open     int     `json:"open"`
+	Done     int     `json:"done"`
+	Canceled int     `json:"canceled"`
+}
+
+func summarizeIssues(issues []client.IssueSummary) listSummary {
+	summary := listSummary{Total: len(issues)}
+	for i := range issues {
+		summary.Estimate += estimateValue(&issues[i])
+		switch issues[i].State.Type {
+		case "completed":
+			summary.Done++
+		case "canceled":
+			summary.Canceled++
+		default:
+			summary.Open++
+		}
+	}
+	return summary
+}
+
+func (s listSummary) String() string {
+	return fmt.Sprintf("%d %s · estimate %s · %d open, %d done, %d canceled",
+		s.Total, pluralIssues(s.Total), formatEstimate(s.Estimate), s.Open, s.Done, s.Canceled)
+}
@@ -0,0 +1,224 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/junipery17/lineartui/internal/client"
+	"github.com/junipery17/lineartui/internal/filter"
+	"github.com/spf13/cobra"
+)
+
+// buildIssueFilter combines base (a saved view's expression, if any), the
+// expression from --filter and the positional args with the typed flags and
+// the team scope. Issues of a Linear custom view are already scoped by the
+// view, so scopeTeam is false for them and only an explicit --team applies.
+func buildIssueFilter(ctx context.Context, cmd *cobra.Command, args []string, base string, scopeTeam bool) (client.IssueFilter, error) {
+	expr, _ := cmd.Flags().GetString("filter")
+	expr = base + " " + expr
+	for _, arg := range args {
+		expr += " " + quoteFilterArg(arg)
+	}
+	query, err := filter.Parse(strings.TrimSpace(expr))
+	if err != nil {
+		return nil, err
+	}
+
+	states, _ := cmd.Flags().GetStringSlice("state")
+	for _, state := range states {
+		if err := query.Add("state", state, "--state "+state); err != nil {
+			return nil, err
+		}
+	}
+	if assignee, _ := cmd.Flags().GetString("assignee"); assignee != "" {
+		if err := query.Add("assignee", assignee, "--assignee "+assignee); err != nil {
+			return nil, err
+		}
+	}
+	labels, _ := cmd.Flags().GetStringSlice("label")
+	for _, label := range labels {
+		if err := query.Add("label", label, "--label "+label); err != nil {
+			return nil, err
+		}
+	}
+	if since, _ := cmd.Flags().GetString("since"); since != "" {
+		if err := query.Add("updated", ">="+since, "--since "+since); err != nil {
+			return nil, err
+		}
+	}
+
+	compiled, err := query.Compile(filter.Resolver{
+		User: func(name string) (string, error) {
+			return resolveAssignee(ctx, name)
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// An explicit --team always applies. Otherwise only a bare listing is
+	// scoped to the default team, which must then be known; any filter,
+	// including team:, searches the whole workspace.
+	team, _ := cmd.Flags().GetString("team")
+	if team == "" && (!scopeTeam || len(query.Terms) > 0) {
+		return client.IssueFilter(compiled), nil
+	}
+	teamID, err := resolveTeamID(ctx, team)
+	if err != nil {
+		return nil, err
+	}
+	teamFilter := map[string]any{"team": map[string]any{"id": map[string]any{"eq": teamID}}}
+	if len(compiled) == 0 {
+		return client.IssueFilter(teamFilter), nil
+	}
+	return client.IssueFilter{"and": []any{teamFilter, compiled}}, nil
+}
+
+// quoteFilterArg re-quotes a shell argument whose value contains spaces, so
+// `project:"Q4 Infra"` survives the shell stripping its quotes.
+func quoteFilterArg(arg string) string {
+	if !strings.ContainsAny(arg, " \t") || strings.Contains(arg, `"`) {
+		return arg
+	}
+	field, value, ok := strings.Cut(arg, ":")
+	if !ok {
+		return `"` + arg + `"`
+	}
+	op := ""
+	for _, prefix := range []string{"<=", ">=", "<", ">", "!"} {
+		if strings.HasPrefix(value, prefix) {
+			op, value = prefix, value[len(prefix):]
+			break
+		}
+	}
+	return field + ":" + op + `"` + value + `"`
+}
+
+type listOptions struct {
+	titlesOnly bool
+	groupBy    string
+	tree       bool
+}
+
+func printIssueList(issues []client.IssueSummary, opts listOptions) error {
+	if opts.tree {
+		return printIssueTree(buildIssueTree(issues), opts.titlesOnly)
+	}
+
+	var groups []issueGroup
+	if opts.groupBy != "" {
+		var err error
+		groups, err = groupIssues(issues, opts.groupBy)
+		if err != nil {
+			return err
+		}
+	}
+
+	switch outputFormat {
+	case outputJSON:
+		if issues == nil {
+			issues = []client.IssueSummary{}
+		}
+		if opts.groupBy == "" {
+			return printJSON(issues)
+		}
+		return printJSON(map[string]any{
+			"groupBy": opts.groupBy,
+			"groups":  groups,
+			"summary": summarizeIssues(issues),
+		})
+	case outputCSV:
+		if opts.groupBy == "" {
+			rows := make([][]string, 0, len(issues))
+			for _, issue := range issues {
+				rows = append(rows, issueRow(issue))
+			}
+			return printCSV(issueColumns, rows)
+		}
+		var rows [][]string
+		for _, group := range groups {
+			for _, issue := range group.Issues {
+				rows = append(rows, append([]string{group.Key}, issueRow(issue)...))
+			}
+		}
+		return printCSV(append([]string{"group"}, issueColumns...), rows)
+	}
+
+	if len(issues) == 0 {
+		fmt.Println("No issues found.")
+		return nil
+	}
+	if opts.groupBy == "" {
+		printIssueLines(issues, opts.titlesOnly, "")
+	} else {
+		for i, group := range groups {
+			if i > 0 {
+				fmt.Println()
+			}
+			fmt.Printf("%s (%d)\n", group.Key, group.Count)
+			printIssueLines(group.Issues, opts.titlesOnly, "  ")
+		}
+	}
+	if !opts.titlesOnly {
+		fmt.Printf("\n%s\n", summarizeIssues(issues))
+	}
+	return nil
+}
+
+func printIssueLines(issues []client.IssueSummary, titlesOnly bool, prefix string) {
+	for i, issue := range issues {
+		if titlesOnly {
+			fmt.Printf("%s%d: %s\n", prefix, i+1, issue.Title)
+			continue
+		}
+		title := string(issue.Title)
+		if issue.ArchivedAt != nil {
+			title += " (archived)"
+		}
+		fmt.Printf("%s%-10s %-14s %-12s %-18s %s\n", prefix, issue.Identifier, truncate(string(issue.State.Name), 14), issue.PriorityLabel, truncate(assigneeName(&issue), 18), title)
+	}
+}
+
+var issueColumns = []string{"identifier", "title", "state", "priority", "assignee", "labels", "project", "cycle", "estimate", "updated", "url"}
+
+func issueRow(issue client.IssueSummary) []string {
+	assignee, project, cycle, estimate := "", "", "", ""
+	if issue.Assignee != nil {
+		assignee = string(issue.Assignee.Name)
+	}
+	if issue.Project != nil {
+		project = string(issue.Project.Name)
+	}
+	if issue.Cycle != nil {
+		cycle = fmt.Sprint(int(issue.Cycle.Number))
+	}
+	if issue.Estimate != nil {
+		estimate = fmt.Sprint(float64(*issue.Estimate))
+	}
+	labels := make([]string, 0, len(issue.Labels.Nodes))
+	for _, label := range issue.Labels.Nodes {
+		labels = append(labels, string(label.Name))
+	}
+	return []string{
+		string(issue.Identifier),
+		string(issue.Title),
+		string(issue.State.Name),
+		string(issue.PriorityLabel),
+		assignee,
+		strings.Join(labels, ";"),
+		project,
+		cycle,
+		estimate,
+		issue.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		string(issue.URL),
+	}
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-1]) + "…"
+}
@@ -0,0 +1,167 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/junipery17/lineartui/internal/client"
+	"github.com/shurcooL/graphql"
+)
+
+// issueNode is an issue with its sub-issues. Estimate and Progress are
+// rolled up over the whole subtree: Estimate sums every estimate in it and
+// Progress is the percentage of its issues that are completed, leaving
+// canceled ones out.
+type issueNode struct {
+	Issue    client.IssueSummary `json:"issue"`
+	Estimate float64             `json:"estimate"`
+	Progress float64             `json:"progress"`
+	Children []*issueNode        `json:"children,omitempty"`
+
+	done, counted int
+}
+
+// buildIssueTree links issues to their parents. Issues whose parent is not
+// in the list become roots; the list order is kept among siblings.
+func buildIssueTree(issues []client.IssueSummary) []*issueNode {
+	nodes := make(map[graphql.String]*issueNode, len(issues))
+	var unique []client.IssueSummary
+	for _, issue := range issues {
+		if _, ok := nodes[issue.ID]; !ok {
+			nodes[issue.ID] = &issueNode{Issue: issue}
+			unique = append(unique, issue)
+		}
+	}
+	var roots []*issueNode
+	for _, issue := range unique {
+		node := nodes[issue.ID]
+		if issue.Parent != nil {
+			if parent, ok := nodes[issue.Parent.ID]; ok && parent != node {
+				parent.Children = append(parent.Children, node)
+				continue
+			}
+		}
+		roots = append(roots, node)
+	}
+	for _, root := range roots {
+		root.rollUp()
+	}
+	return roots
+}
+
+func (n *issueNode) rollUp() {
+	n.Estimate = estimateValue(&n.Issue)
+	n.done, n.counted = 0, 0
+	switch n.Issue.State.Type {
+	case "completed":
+		n.done, n.counted = 1, 1
+	case "canceled":
+	default:
+		n.counted = 1
+	}
+	for _, child := range n.Children {
+		child.rollUp()
+		n.Estimate += child.Estimate
+		n.done += child.done
+		n.counted += child.counted
+	}
+	if n.counted > 0 {
+		n.Progress = float64(100*n.done) / float64(n.counted)
+	}
+}
+
+// fetchChildren returns the sub-issues of parentID, one level at a time
+// when recursive, in a single list ready for buildIssueTree.
+func fetchChildren(ctx context.Context, parentID string, recursive bool, includeArchived bool) ([]client.IssueSummary, error) {
+	var all []client.IssueSummary
+	seen := map[string]bool{parentID: true}
+	level := []any{parentID}
+	for len(level) > 0 {
+		filter := client.IssueFilter{"parent": map[string]any{"id": map[string]any{"in": level}}}
+		children, err := linearClient.ListIssues(ctx, filter, client.ListOptions{IncludeArchived: includeArchived})
+		if err != nil {
+			return nil, err
+		}
+		level = nil
+		for _, child := range children {
+			if seen[string(child.ID)] {
+				continue
+			}
+			seen[string(child.ID)] = true
+			all = append(all, child)
+			level = append(level, string(child.ID))
+		}
+		if !recursive {
+			break
+		}
+	}
+	return all, nil
+}
+
+func printIssueTree(roots []*issueNode, titlesOnly bool) error {
+	switch outputFormat {
+	case outputJSON:
+		if roots == nil {
+			roots = []*issueNode{}
+		}
+		return printJSON(roots)
+	case outputCSV:
+		var rows [][]string
+		var walk func(nodes []*issueNode, parent string, depth int)
+		walk = func(nodes []*issueNode, parent string, depth int) {
+			for _, node := range nodes {
+				row := []string{parent, strconv.Itoa(depth), formatEstimate(node.Estimate), formatProgress(node.Progress)}
+				rows = append(rows, append(row, issueRow(node.Issue)...))
+				walk(node.Children, string(node.Issue.Identifier), depth+1)
+			}
+		}
+		walk(roots, "", 0)
+		header := append([]string{"parent", "depth", "rolled_estimate", "progress"}, issueColumns...)
+		return printCSV(header, rows)
+	}
+
+	if len(roots) == 0 {
+		fmt.Println("No issues found.")
+		return nil
+	}
+	var walk func(nodes []*issueNode, prefix string)
+	walk = func(nodes []*issueNode, prefix string) {
+		for i, node := range nodes {
+			branch, next := "├── ", "│   "
+			if i == len(nodes)-1 {
+				branch, next = "└── ", "    "
+			}
+			fmt.Println(prefix + branch + treeLine(node, titlesOnly))
+			walk(node.Children, prefix+next)
+		}
+	}
+	for _, root := range roots {
+		fmt.Println(treeLine(root, titlesOnly))
+		walk(root.Children, "")
+	}
+	return nil
+}
+
+func treeLine(node *issueNode, titlesOnly bool) string {
+	issue := node.Issue
+	if titlesOnly {
+		return string(issue.Title)
+	}
+	line := fmt.Sprintf("%s [%s] %s", issue.Identifier, issue.State.Name, issue.Title)
+	if node.Estimate > 0 {
+		line += " · est " + formatEstimate(node.Estimate)
+	}
+	if len(node.Children) > 0 {
+		line += " · " + formatProgress(node.Progress) + " done"
+	}
+	return line
+}
+
+func formatEstimate(estimate float64) string {
+	return strconv.FormatFloat(estimate, 'f', -1, 64)
+}
+
+func formatProgress(progress float64) string {
+	return fmt.Sprintf("%.0f%%", progress)
+}
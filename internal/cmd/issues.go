@@ -3,9 +3,16 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"strconv"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/junipery17/lineartui/internal/client"
+	"github.com/junipery17/lineartui/internal/filter"
+	"github.com/shurcooL/graphql"
 	"github.com/spf13/cobra"
 )
 
@@ -16,164 +23,550 @@ var issuesCmd = &cobra.Command{
 }
 
 var issuesListCmd = &cobra.Command{
-	Use:   "list",
-	Short: "List issues for a team",
+	Use:   "list [filter...]",
+	Short: "List issues, optionally filtered",
+	Long: `List issues of the default team, or across the workspace when a filter
+is given. --team limits any listing to that team.
+
+Filters are space-separated field:value terms combined with AND:
+
+  state:started assignee:@me label:bug priority:<=2 updated:>7d
+  project:"Q4 Infra" state:!done -- -label:wontfix
+
+Fields: ` + strings.Join(filter.Fields, ", ") + `.
+
+Prefix a term with - or its value with ! to negate it; terms starting with
+- must come after -- or inside --filter so they are not read as flags.
+Numbers and dates accept <, <=, > and >=. No priority ranks below low, so
+priority:<=2 means urgent or high. Dates are 2006-01-02 or a duration back
+from now (36h, 7d, 2w), so updated:>7d means updated within the last 7
+days. Bare words match titles.
+
+due: looks ahead instead: durations count forward and a bare value means
+"due by", so due:7d lists issues due within the next week, overdue ones
+included, and due:>2w those due later than two weeks from now.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		var teamID string
-		teamName, _ := cmd.Flags().GetString("team")
-		if teamName == "" {
-			teamID = cfg.Linear.TeamID
+		ctx := context.Background()
+		limit, _ := cmd.Flags().GetInt("limit")
+		includeArchived, _ := cmd.Flags().GetBool("include-archived")
+		opts := client.ListOptions{Limit: limit, IncludeArchived: includeArchived}
+		viewName, _ := cmd.Flags().GetString("view")
+
+		// Saved views in the config win over Linear custom views.
+		viewExpr, localView := cfg.View(viewName)
+		var issues []client.IssueSummary
+		if viewName == "" || localView {
+			issueFilter, err := buildIssueFilter(ctx, cmd, args, viewExpr, true)
+			if err != nil {
+				return err
+			}
+			issues, err = linearClient.ListIssues(ctx, issueFilter, opts)
+			if err != nil {
+				return err
+			}
 		} else {
-			var err error
-			teamID, err = linearClient.FindTeamByName(context.Background(), teamName)
+			view, err := findCustomView(ctx, viewName)
+			if err != nil {
+				return err
+			}
+			issueFilter, err := buildIssueFilter(ctx, cmd, args, "", false)
+			if err != nil {
+				return err
+			}
+			issues, err = linearClient.ListCustomViewIssues(ctx, string(view.ID), issueFilter, opts)
 			if err != nil {
 				return err
 			}
 		}
-		if teamID == "" {
-			return fmt.Errorf("team ID required. Use --team flag or set linear.team_id in config")
+		if sortSpec, _ := cmd.Flags().GetString("sort"); sortSpec != "" {
+			if err := sortIssues(issues, sortSpec); err != nil {
+				return err
+			}
 		}
 		titlesOnly, _ := cmd.Flags().GetBool("titles")
-		ctx := context.Background()
-		fmt.Printf("Listing issues for team %s...\n", teamID)
-		return linearClient.DisplayIssues(ctx, teamID, titlesOnly)
+		groupBy, _ := cmd.Flags().GetString("group-by")
+		tree, _ := cmd.Flags().GetBool("tree")
+		return printIssueList(issues, listOptions{titlesOnly: titlesOnly, groupBy: groupBy, tree: tree})
 	},
 }
 
 var issuesCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a new issue",
+	Long: `Create an issue with any of its fields set in one go, e.g.
+
+  lineartui issues create -T "Login fails on Safari" --priority high \
+    --assignee @me --label bug --estimate 3 --due "next friday"
+
+Defaults for the project, labels and description template come from the
+config. Labels given with --label are added to the default labels.
+
+With --template the issue starts from a template instead: a local one
+prefixes the title and supplies the description, labels, priority and
+sub-issues, with placeholders filled from --var or asked for; a Linear
+workspace template is applied by Linear. Flags still override it.
+
+  lineartui issues create --template bug -T "Login fails on Safari" \
+    --var Steps="Open /login in Safari 17"
+
+See 'lineartui templates list' for the available templates.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if cfg.Linear.APIKey == "" {
-			return fmt.Errorf("linear API key not configured. Set LINEARTUI_LINEAR_API_KEY or add it to config.yaml")
+		ctx := context.Background()
+		var tmpl *issueTemplate
+		vars, _ := cmd.Flags().GetStringArray("var")
+		if name, _ := cmd.Flags().GetString("template"); name != "" {
+			var err error
+			tmpl, err = findIssueTemplate(ctx, name)
+			if err != nil {
+				return err
+			}
+			if tmpl.LinearID == "" {
+				tmpl, err = tmpl.render(vars)
+				if err != nil {
+					return err
+				}
+			} else if len(vars) > 0 {
+				return fmt.Errorf("--var only applies to local templates, %s is a workspace template", tmpl.Name)
+			}
+		} else if len(vars) > 0 {
+			return fmt.Errorf("--var needs --template")
+		}
+
+		input, err := issueCreateInput(ctx, cmd, tmpl)
+		if err != nil {
+			return err
 		}
-		title, _ := cmd.Flags().GetString("title")
-		if title == "" {
-			return fmt.Errorf("title is required")
+		issue, err := linearClient.CreateIssue(ctx, *input)
+		if err != nil {
+			return err
 		}
-		teamID, _ := cmd.Flags().GetString("team")
-		if teamID == "" {
-			teamID = cfg.Linear.TeamID
+		var subIssues []*client.IssueData
+		if tmpl != nil {
+			subIssues, err = createSubIssues(ctx, issue, input, tmpl.SubIssues)
+			if err != nil {
+				return fmt.Errorf("created %s, but not all of its sub-issues: %w", issue.Identifier, err)
+			}
 		}
-		if teamID == "" {
-			return fmt.Errorf("team ID required. Use --team flag or set linear.team_id in config")
+		if outputFormat == outputJSON {
+			return printJSON(struct {
+				*client.IssueData
+				SubIssues []*client.IssueData `json:",omitempty"`
+			}{issue, subIssues})
 		}
-		description, _ := cmd.Flags().GetString("description")
-		fmt.Printf("Creating issue '%s' in team %s...\n", title, teamID)
-		if description != "" {
-			fmt.Printf("Description: %s\n", description)
+		fmt.Printf("Created %s: %s\n%s\n", issue.Identifier, issue.Title, issue.URL)
+		for _, sub := range subIssues {
+			fmt.Printf("  Created sub-issue %s: %s\n", sub.Identifier, sub.Title)
 		}
-		ctx := context.Background()
-		_, err := linearClient.AddIssue(ctx, teamID, title, description)
-
-		return err
+		return nil
 	},
 }
 
-var issuesDeleteCmd = &cobra.Command{
-	Use:   "delete [issue-id]",
-	Short: "Delete an issue",
-	Args:  cobra.ExactArgs(1),
-	RunE: func(cmd *cobra.Command, args []string) error {
-		if cfg.Linear.APIKey == "" {
-			return fmt.Errorf("linear API key not configured. Set LINEARTUI_LINEAR_API_KEY or add it to config.yaml")
+// issueCreateInput resolves the create flags, and the template and config
+// defaults they fall back to, into a single issueCreate input. tmpl may be
+// nil.
+func issueCreateInput(ctx context.Context, cmd *cobra.Command, tmpl *issueTemplate) (*client.IssueCreateInput, error) {
+	flags := cmd.Flags()
+	title, _ := flags.GetString("title")
+	if tmpl != nil && tmpl.LinearID == "" {
+		if title == "" && stdinIsTerminal() {
+			var err error
+			if title, err = prompt("Title", ""); err != nil {
+				return nil, err
+			}
 		}
-		issueID := args[0]
-		// TODO: implement issue deletion
-		fmt.Printf("Deleting issue %s...\n", issueID)
-		ctx := context.Background()
-		return linearClient.DeleteIssue(ctx, issueID)
-	},
+		if title != "" {
+			title = tmpl.TitlePrefix + title
+		}
+	}
+	// A workspace template may bring its own title.
+	if title == "" && (tmpl == nil || tmpl.LinearID == "") {
+		return nil, fmt.Errorf("title is required")
+	}
+	team, _ := flags.GetString("team")
+	if team == "" && tmpl != nil {
+		team = tmpl.Team
+	}
+	teamID, err := resolveTeamID(ctx, team)
+	if err != nil {
+		return nil, err
+	}
+	input := &client.IssueCreateInput{
+		Title:  graphql.String(title),
+		TeamID: graphql.String(teamID),
+	}
+
+	description, _ := flags.GetString("description")
+	if description == "" && tmpl != nil {
+		description = tmpl.Description
+	}
+	if description == "" && (tmpl == nil || tmpl.LinearID == "") {
+		description, err = defaultDescription()
+		if err != nil {
+			return nil, err
+		}
+	}
+	input.Description = graphql.String(description)
+
+	// A workspace template brings its own project and labels, which the
+	// config defaults must not replace; flags still win.
+	useDefaults := tmpl == nil || tmpl.LinearID == ""
+	project, _ := flags.GetString("project")
+	if project == "" && useDefaults {
+		project = cfg.Defaults.Project
+	}
+	projectID, err := resolveProjectID(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+	input.ProjectID = graphql.String(projectID)
+
+	labels, _ := flags.GetStringSlice("label")
+	if tmpl != nil {
+		labels = append(slices.Clone(tmpl.Labels), labels...)
+	}
+	if useDefaults {
+		labels = append(slices.Clone(cfg.Defaults.Labels), labels...)
+	}
+	labelIDs, err := linearClient.LabelIDs(ctx, labels)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range labelIDs {
+		if !slices.Contains(input.LabelIDs, graphql.String(id)) {
+			input.LabelIDs = append(input.LabelIDs, graphql.String(id))
+		}
+	}
+
+	priorityValue, _ := flags.GetString("priority")
+	if priorityValue == "" && tmpl != nil {
+		priorityValue = tmpl.Priority
+	}
+	if priorityValue != "" {
+		priority, err := parsePriority(priorityValue)
+		if err != nil {
+			return nil, err
+		}
+		input.Priority = graphql.NewInt(graphql.Int(priority))
+	}
+	if tmpl != nil {
+		input.TemplateID = graphql.String(tmpl.LinearID)
+	}
+	if value, _ := flags.GetString("assignee"); value != "" {
+		assigneeID, err := resolveAssignee(ctx, value)
+		if err != nil {
+			return nil, err
+		}
+		input.AssigneeID = graphql.String(assigneeID)
+	}
+	if value, _ := flags.GetString("estimate"); value != "" {
+		estimate, err := parseEstimate(ctx, teamID, value)
+		if err != nil {
+			return nil, err
+		}
+		input.Estimate = graphql.NewInt(graphql.Int(estimate))
+	}
+	if value, _ := flags.GetString("due"); value != "" {
+		due, err := parseDueDate(value, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		input.DueDate = graphql.String(due)
+	}
+	if value, _ := flags.GetString("cycle"); value != "" {
+		cycleID, err := resolveCycle(ctx, teamID, value)
+		if err != nil {
+			return nil, err
+		}
+		input.CycleID = graphql.String(cycleID)
+	}
+	if value, _ := flags.GetString("state"); value != "" {
+		states, err := linearClient.GetWorkflowStates(ctx, teamID)
+		if err != nil {
+			return nil, err
+		}
+		state, err := matchState(states, value)
+		if err != nil {
+			return nil, err
+		}
+		input.StateID = state.ID
+	}
+	if value, _ := flags.GetString("parent"); value != "" {
+		parent, err := resolveIssue(ctx, value)
+		if err != nil {
+			return nil, err
+		}
+		input.ParentID = parent.ID
+	}
+	return input, nil
 }
 
-var issuesUpdateCmd = &cobra.Command{
-	Use:   "update [issue-id]",
-	Short: "Modify an existing issue",
-	RunE: func(cmd *cobra.Command, args []string) error {
-		if cfg.Linear.APIKey == "" {
-			return fmt.Errorf("linear API key not configured. Set LINEARTUI_LINEAR_API_KEY or add it to config.yaml")
+// createSubIssues creates a template's sub-issues under parent, in the
+// parent's team and project.
+func createSubIssues(ctx context.Context, parent *client.IssueData, parentInput *client.IssueCreateInput, subIssues []templateSubIssue) ([]*client.IssueData, error) {
+	var created []*client.IssueData
+	for _, sub := range subIssues {
+		input := client.IssueCreateInput{
+			Title:       graphql.String(sub.Title),
+			Description: graphql.String(sub.Description),
+			TeamID:      parentInput.TeamID,
+			ProjectID:   parentInput.ProjectID,
+			ParentID:    parent.ID,
 		}
-		issueID, _ := cmd.Flags().GetString("issueID")
-		title, _ := cmd.Flags().GetString("titleSearch")
-		if title != "" {
-			var err error
-			issueID, err = linearClient.FindIssueByTitle(context.Background(), cfg.Linear.TeamID, title)
-			if err != nil {
-				return err
-			}
+		labelIDs, err := linearClient.LabelIDs(ctx, sub.Labels)
+		if err != nil {
+			return created, err
 		}
-		assign, _ := cmd.Flags().GetString("assign")
-		if assign != "" {
-			fmt.Printf("Updating issue %s...\n", issueID)
-			err := linearClient.UpdateAssigneeOnIssue(context.Background(), issueID, assign)
-			if err != nil {
-				return err
-			}
+		for _, id := range labelIDs {
+			input.LabelIDs = append(input.LabelIDs, graphql.String(id))
 		}
-		description, _ := cmd.Flags().GetString("description")
-		if description != "" {
-			fmt.Printf("Updating description on issue %s...\n", issueID)
-			err := linearClient.UpdateDescriptionOnIssue(context.Background(), issueID, description)
+		if sub.Priority != "" {
+			priority, err := parsePriority(sub.Priority)
 			if err != nil {
-				return err
+				return created, err
 			}
+			input.Priority = graphql.NewInt(graphql.Int(priority))
+		}
+		issue, err := linearClient.CreateIssue(ctx, input)
+		if err != nil {
+			return created, err
+		}
+		created = append(created, issue)
+	}
+	return created, nil
+}
+
+var issuesDeleteCmd = &cobra.Command{
+	Use:   "delete <issue>...",
+	Short: "Move issues to the trash",
+	Long: `Move issues to the trash. Linear keeps deleted issues for a grace
+period, during which 'issues trash list' shows them and 'issues restore'
+brings them back.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		issues, err := resolveIssues(ctx, args)
+		if err != nil {
+			return err
 		}
-		priority, _ := cmd.Flags().GetString("priority")
-		if priority != "" {
-			priority, parseErr := strconv.ParseInt(priority, 10, 64)
-			if parseErr != nil || priority < 0 || priority > 4 {
-				return fmt.Errorf("Priority must be an integer from 0 to 4")
+		if yes, _ := cmd.Flags().GetBool("yes"); !yes {
+			for _, issue := range issues {
+				fmt.Printf("  %s\n", formatIssueRef(issue))
 			}
-			err := linearClient.UpdatePriorityOnIssue(context.Background(), issueID, float64(priority))
+			ok, err := confirm(fmt.Sprintf("Delete %d %s?", len(issues), pluralIssues(len(issues))))
 			if err != nil {
 				return err
 			}
-		}
-		status, _ := cmd.Flags().GetString("status")
-		if status != "" {
-			status = strings.ToLower(status)
-			statID := StatusToID[status]
-			err := linearClient.UpdateStatusOnIssue(context.Background(), issueID, statID)
-			if err != nil {
-				return err
+			if !ok {
+				fmt.Println("Aborted.")
+				return nil
 			}
 		}
-		return nil
+		return forEachIssue(issues, "deleted", func(issue *client.IssueRef) error {
+			return linearClient.DeleteIssue(ctx, string(issue.ID))
+		})
+	},
+}
+
+var issuesUpdateCmd = &cobra.Command{
+	Use:   "update [issue]",
+	Short: "Modify an existing issue",
+	Long: `Modify an existing issue. All changes are sent in a single update, so
+either every flag takes effect or none does.
+
+Labels are added and removed with comma-separated sets, e.g.
+--add-label bug,regression --remove-label triage.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		issue, err := issueFromArgs(cmd, args)
+		if err != nil {
+			return err
+		}
+		input, err := issueUpdateInput(ctx, cmd, issue)
+		if err != nil {
+			return err
+		}
+		return applyIssueUpdate(ctx, issue, input)
 	},
 }
 
 var issueUpdateLabelCmd = &cobra.Command{
-	Use:   "label [issue-id]",
+	Use:   "label [issue]",
 	Short: "Update and edit labels on issue",
+	Args:  cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if cfg.Linear.APIKey == "" {
-			return fmt.Errorf("linear API key not configured. Set LINEARTUI_LINEAR_API_KEY or add it to config.yaml")
+		ctx := context.Background()
+		issue, err := issueFromArgs(cmd, args)
+		if err != nil {
+			return err
 		}
-		issueID, _ := cmd.Flags().GetString("issueID")
-		title, _ := cmd.Flags().GetString("titleSearch")
-		if title != "" {
-			var err error
-			issueID, err = linearClient.FindIssueByTitle(context.Background(), cfg.Linear.TeamID, title)
-			if err != nil {
-				return err
-			}
+		add, _ := cmd.Flags().GetStringSlice("add")
+		remove, _ := cmd.Flags().GetStringSlice("remove")
+		input := client.IssueUpdateInput{}
+		if err := addLabelChanges(ctx, input, string(issue.ID), add, remove); err != nil {
+			return err
 		}
-		add, _ := cmd.Flags().GetString("add")
-		if add != "" {
-			err := linearClient.AddLabeltoIssue(context.Background(), issueID, add)
-			if err != nil {
-				return err
-			}
+		return applyIssueUpdate(ctx, issue, input)
+	},
+}
+
+// issueUpdateInput collects every update flag that was set into one
+// partial IssueUpdateInput.
+func issueUpdateInput(ctx context.Context, cmd *cobra.Command, issue *client.IssueRef) (client.IssueUpdateInput, error) {
+	flags := cmd.Flags()
+	input := client.IssueUpdateInput{}
+
+	if flags.Changed("title") {
+		title, _ := flags.GetString("title")
+		if strings.TrimSpace(title) == "" {
+			return nil, fmt.Errorf("title cannot be empty")
+		}
+		input["title"] = title
+	}
+	if flags.Changed("description") {
+		input["description"], _ = flags.GetString("description")
+	}
+	if assign, _ := flags.GetString("assign"); assign != "" {
+		assigneeID, err := resolveAssignee(ctx, assign)
+		if err != nil {
+			return nil, err
+		}
+		// An empty ID means unassign, which Linear takes as null.
+		if assigneeID == "" {
+			input["assigneeId"] = nil
+		} else {
+			input["assigneeId"] = assigneeID
+		}
+	}
+	if value, _ := flags.GetString("priority"); value != "" {
+		priority, err := parsePriority(value)
+		if err != nil {
+			return nil, err
 		}
-		remove, _ := cmd.Flags().GetString("remove")
-		if remove != "" {
-			err := linearClient.RemoveLabelFromIssue(context.Background(), issueID, remove)
+		input["priority"] = priority
+	}
+	if status, _ := flags.GetString("status"); status != "" {
+		state, err := resolveState(ctx, string(issue.ID), status)
+		if err != nil {
+			return nil, err
+		}
+		input["stateId"] = string(state.ID)
+	}
+	if flags.Changed("parent") {
+		parent, _ := flags.GetString("parent")
+		if strings.EqualFold(parent, "none") {
+			input["parentId"] = nil
+		} else {
+			parentIssue, err := resolveIssue(ctx, parent)
 			if err != nil {
-				return err
+				return nil, err
+			}
+			if parentIssue.ID == issue.ID {
+				return nil, fmt.Errorf("an issue cannot be its own parent")
 			}
+			input["parentId"] = string(parentIssue.ID)
 		}
+	}
+	add, _ := flags.GetStringSlice("add-label")
+	remove, _ := flags.GetStringSlice("remove-label")
+	if err := addLabelChanges(ctx, input, string(issue.ID), add, remove); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// addLabelChanges sets labelIds to the issue's current labels plus add and
+// minus remove. Labels to add are created if they do not exist yet.
+func addLabelChanges(ctx context.Context, input client.IssueUpdateInput, issueID string, add []string, remove []string) error {
+	add, remove = trimAll(add), trimAll(remove)
+	if len(add) == 0 && len(remove) == 0 {
 		return nil
-	},
+	}
+	current, err := linearClient.GetIssueLabels(ctx, issueID)
+	if err != nil {
+		return err
+	}
+	for _, name := range remove {
+		found := false
+		for _, label := range current {
+			found = found || strings.EqualFold(string(label.Name), name)
+		}
+		if !found {
+			return fmt.Errorf("the issue has no label %q", name)
+		}
+	}
+
+	labelIDs := []string{}
+	for _, label := range current {
+		if !slices.ContainsFunc(remove, func(name string) bool { return strings.EqualFold(name, string(label.Name)) }) {
+			labelIDs = append(labelIDs, string(label.ID))
+		}
+	}
+	added, err := linearClient.LabelIDs(ctx, add)
+	if err != nil {
+		return err
+	}
+	for _, id := range added {
+		if !slices.Contains(labelIDs, id) {
+			labelIDs = append(labelIDs, id)
+		}
+	}
+	input["labelIds"] = labelIDs
+	return nil
+}
+
+func trimAll(values []string) []string {
+	var out []string
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			out = append(out, value)
+		}
+	}
+	return out
+}
+
+func applyIssueUpdate(ctx context.Context, issue *client.IssueRef, input client.IssueUpdateInput) error {
+	if len(input) == 0 {
+		return fmt.Errorf("nothing to update, pass at least one flag")
+	}
+	if _, err := linearClient.UpdateIssue(ctx, string(issue.ID), input); err != nil {
+		return err
+	}
+	fields := make([]string, 0, len(input))
+	for field := range input {
+		fields = append(fields, updateFieldNames[field])
+	}
+	sort.Strings(fields)
+	fmt.Printf("Updated %s: %s\n", issue.Identifier, strings.Join(fields, ", "))
+	return nil
+}
+
+var updateFieldNames = map[string]string{
+	"title":       "title",
+	"description": "description",
+	"assigneeId":  "assignee",
+	"priority":    "priority",
+	"stateId":     "state",
+	"parentId":    "parent",
+	"labelIds":    "labels",
+}
+
+// defaultDescription reads defaults.template, resolved relative to the
+// config file that set it.
+func defaultDescription() (string, error) {
+	path := cfg.Defaults.Template
+	if path == "" {
+		return "", nil
+	}
+	origin := cfg.Origin("defaults.template")
+	if !filepath.IsAbs(path) && origin != "default" && !strings.HasPrefix(origin, "env ") {
+		path = filepath.Join(filepath.Dir(origin), path)
+	}
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read issue template: %w", err)
+	}
+	return string(raw), nil
 }
 
 func init() {
@@ -185,31 +578,57 @@ func init() {
 	issuesCmd.AddCommand(issueUpdateLabelCmd)
 
 	// Flags for list command
-	issuesListCmd.Flags().StringP("team", "t", "", "Team Name to list issues for")
+	issuesListCmd.Flags().StringP("team", "t", "", "Team name or ID to list issues for")
 	issuesListCmd.Flags().BoolP("titles", "T", false, "List only titles of Issues")
+	issuesListCmd.Flags().StringP("filter", "f", "", "Filter expression, e.g. 'state:started assignee:@me'")
+	issuesListCmd.Flags().StringSlice("state", nil, "Only issues in this state name or type")
+	issuesListCmd.Flags().String("assignee", "", "Only issues assigned to this user, @me or none")
+	issuesListCmd.Flags().StringSlice("label", nil, "Only issues with this label (repeatable)")
+	issuesListCmd.Flags().String("since", "", "Only issues updated since a date or duration, e.g. 7d")
+	issuesListCmd.Flags().Int("limit", 250, "Maximum number of issues to list, 0 for all")
+	issuesListCmd.Flags().Bool("include-archived", false, "Include archived issues")
+	issuesListCmd.Flags().String("view", "", "Run a saved view from the config or a Linear custom view")
+	issuesListCmd.Flags().String("sort", "", "Sort keys, - for descending, e.g. priority,-updated ("+strings.Join(sortKeys, ", ")+")")
+	issuesListCmd.Flags().String("group-by", "", "Group issues by "+strings.Join(groupKeys, ", "))
+	issuesListCmd.Flags().Bool("tree", false, "Show sub-issues under their parents with rolled-up estimate and progress")
+	issuesListCmd.MarkFlagsMutuallyExclusive("tree", "group-by")
 
 	// Flags for create command
-	issuesCreateCmd.Flags().StringP("title", "T", "", "Issue title (required)")
+	issuesCreateCmd.Flags().StringP("title", "T", "", "Issue title (required unless a template supplies one)")
 	issuesCreateCmd.Flags().StringP("description", "d", "", "Issue description")
-	issuesCreateCmd.Flags().StringP("team", "t", "", "Team ID to create issue in")
-	issuesCreateCmd.MarkFlagRequired("title")
+	issuesCreateCmd.Flags().StringP("team", "t", "", "Team ID or name to create issue in")
+	issuesCreateCmd.Flags().StringP("priority", "p", "", "Priority: 0-4 or none, urgent, high, medium, low")
+	issuesCreateCmd.Flags().StringP("assignee", "a", "", "Assignee by name, email or @me")
+	issuesCreateCmd.Flags().StringSliceP("label", "l", nil, "Label to add, created if missing (repeatable)")
+	issuesCreateCmd.Flags().StringP("estimate", "e", "", "Estimate on the team's scale, e.g. 3 or M")
+	issuesCreateCmd.Flags().String("due", "", "Due date: 2006-01-02, today, tomorrow, friday, next week, 3d")
+	issuesCreateCmd.Flags().String("project", "", "Project name or ID (default from config)")
+	issuesCreateCmd.Flags().String("cycle", "", "Cycle: current, next, a number or a name")
+	issuesCreateCmd.Flags().StringP("state", "s", "", "Initial state name or type")
+	issuesCreateCmd.Flags().String("parent", "", "Parent issue ID, identifier or URL")
+	issuesCreateCmd.Flags().String("template", "", "Local or workspace template to start from")
+	issuesCreateCmd.Flags().StringArray("var", nil, "Template variable as name=value (repeatable)")
+
+	issuesDeleteCmd.Flags().BoolP("yes", "y", false, "Delete without asking for confirmation")
 
 	//Flags for updating Issue command
-	issuesUpdateCmd.Flags().StringP("assign", "a", "", "Edit assigned members")
+	issuesUpdateCmd.Flags().StringP("assign", "a", "", "Assignee by name, email, @me, or none to unassign")
 	issuesUpdateCmd.Flags().StringP("description", "d", "", "Edit description")
-	issuesUpdateCmd.Flags().StringP("priority", "p", "", "Set new priority for issue")
-	issuesUpdateCmd.Flags().StringP("issueID", "i", "", "ID of issue to update")
+	issuesUpdateCmd.Flags().StringP("priority", "p", "", "New priority: 0-4 or none, urgent, high, medium, low")
+	issuesUpdateCmd.Flags().StringP("issueID", "i", "", "Issue ID, identifier (ENG-123) or URL to update")
 	issuesUpdateCmd.Flags().StringP("titleSearch", "t", "", "Select issue by title")
-	issuesUpdateCmd.Flags().StringP("status", "s", "", "Update status of issue")
-	issuesUpdateCmd.MarkFlagsOneRequired("issueID", "titleSearch")
+	issuesUpdateCmd.Flags().StringP("status", "s", "", "Update status of issue by state name or type")
+	issuesUpdateCmd.Flags().String("parent", "", "Make the issue a sub-issue of this issue, or none to detach it")
+	issuesUpdateCmd.Flags().StringP("title", "T", "", "New title")
+	issuesUpdateCmd.Flags().StringSlice("add-label", nil, "Comma-separated labels to add, created if missing")
+	issuesUpdateCmd.Flags().StringSlice("remove-label", nil, "Comma-separated labels to remove")
 	issuesUpdateCmd.MarkFlagsMutuallyExclusive("issueID", "titleSearch")
 
 	//Flags for labels
-	issueUpdateLabelCmd.Flags().StringP("issueID", "i", "", "ID of issue to edit label")
+	issueUpdateLabelCmd.Flags().StringP("issueID", "i", "", "Issue ID, identifier (ENG-123) or URL to edit labels on")
 	issueUpdateLabelCmd.Flags().StringP("titleSearch", "t", "", "Issue by title")
-	issueUpdateLabelCmd.Flags().StringP("add", "a", "", "Add a label")
-	issueUpdateLabelCmd.Flags().StringP("remove", "r", "", "Remove a label")
-	issueUpdateLabelCmd.MarkFlagsOneRequired("issueID", "titleSearch")
+	issueUpdateLabelCmd.Flags().StringSliceP("add", "a", nil, "Comma-separated labels to add, created if missing")
+	issueUpdateLabelCmd.Flags().StringSliceP("remove", "r", nil, "Comma-separated labels to remove")
 	issueUpdateLabelCmd.MarkFlagsMutuallyExclusive("issueID", "titleSearch")
 
 }
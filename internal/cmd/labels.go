@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/junipery17/lineartui/internal/client"
 	"github.com/spf13/cobra"
 )
 
@@ -17,11 +18,11 @@ var labelsListCmd = &cobra.Command{
 	Use:   "list labels",
 	Short: "List existing labels",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if cfg.Linear.APIKey == "" {
-			return fmt.Errorf("linear API key not configured. Set LINEARTUI_LINEAR_API_KEY or add it to config.yaml")
-		}
 		issueID, _ := cmd.Flags().GetString("issueID")
 		title, _ := cmd.Flags().GetString("title")
+		if outputFormat != outputText {
+			return printLabels(issueID, title)
+		}
 		if title != "" {
 			var err error
 			issueID, err = linearClient.FindIssueByTitle(context.Background(), cfg.Linear.TeamID, title)
@@ -32,6 +33,11 @@ var labelsListCmd = &cobra.Command{
 		}
 		if issueID != "" {
 			if title == "" {
+				var err error
+				issueID, err = parseIssueRef(issueID)
+				if err != nil {
+					return err
+				}
 				fmt.Printf("listing labels applied to issue: %s\n", issueID)
 			}
 			return linearClient.ListLabels(context.Background(), issueID)
@@ -41,13 +47,50 @@ var labelsListCmd = &cobra.Command{
 	},
 }
 
+// printLabels lists labels as JSON or CSV, of one issue when issueID or
+// title is set.
+func printLabels(issueID string, title string) error {
+	ctx := context.Background()
+	var err error
+	switch {
+	case title != "":
+		issueID, err = linearClient.FindIssueByTitle(ctx, cfg.Linear.TeamID, title)
+	case issueID != "":
+		issueID, err = parseIssueRef(issueID)
+	}
+	if err != nil {
+		return err
+	}
+
+	var labels []client.LabelData
+	if issueID != "" {
+		labels, err = linearClient.GetIssueLabels(ctx, issueID)
+	} else {
+		labels, err = linearClient.GetLabels(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	if outputFormat == outputJSON {
+		if labels == nil {
+			labels = []client.LabelData{}
+		}
+		return printJSON(labels)
+	}
+	rows := make([][]string, 0, len(labels))
+	for _, label := range labels {
+		rows = append(rows, []string{string(label.ID), string(label.Name)})
+	}
+	return printCSV([]string{"id", "name"}, rows)
+}
+
 // apply a given label to multiple issues?
 
 func init() {
 	rootCmd.AddCommand(labelsCmd)
 	labelsCmd.AddCommand(labelsListCmd)
 
-	labelsListCmd.Flags().StringP("issueID", "i", "", "issueID to list labels for")
+	labelsListCmd.Flags().StringP("issueID", "i", "", "Issue ID, identifier (ENG-123) or URL to list labels for")
 	labelsListCmd.Flags().StringP("title", "t", "", "title of issue to list labels for")
 	labelsListCmd.MarkFlagsMutuallyExclusive("issueID", "title")
 }
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	mdLink     = regexp.MustCompile(`\[([^\]]*)\]\(([^)\s]+)\)`)
+	mdEmphasis = regexp.MustCompile(`(\*\*|__)(.+?)(\*\*|__)`)
+	mdHeading  = regexp.MustCompile(`^#{1,6}\s+`)
+	mdBullet   = regexp.MustCompile(`^(\s*)[-*+]\s+`)
+	mdCheckbox = regexp.MustCompile(`^(\s*)[-*+]\s+\[([ xX])\]\s+`)
+)
+
+// renderMarkdown turns Linear's markdown into plain text that reads well
+// in a terminal: headings are underlined, bullets and checkboxes become
+// symbols, links show their target and code blocks are indented.
+func renderMarkdown(text string) string {
+	var out []string
+	inCode := false
+	for _, line := range strings.Split(strings.TrimRight(text, "\n"), "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inCode = !inCode
+			continue
+		}
+		if inCode {
+			out = append(out, "    "+line)
+			continue
+		}
+
+		if mdHeading.MatchString(line) {
+			heading := renderInline(mdHeading.ReplaceAllString(line, ""))
+			out = append(out, heading, strings.Repeat("─", len([]rune(heading))))
+			continue
+		}
+		if match := mdCheckbox.FindStringSubmatch(line); match != nil {
+			box := "☐ "
+			if match[2] != " " {
+				box = "☑ "
+			}
+			line = match[1] + box + line[len(match[0]):]
+		} else {
+			line = mdBullet.ReplaceAllString(line, "$1• ")
+		}
+		if rest, ok := strings.CutPrefix(line, ">"); ok {
+			line = "│ " + strings.TrimPrefix(rest, " ")
+		}
+		out = append(out, renderInline(line))
+	}
+	return strings.Join(out, "\n")
+}
+
+func renderInline(line string) string {
+	line = mdLink.ReplaceAllStringFunc(line, func(link string) string {
+		match := mdLink.FindStringSubmatch(link)
+		if match[1] == "" || match[1] == match[2] {
+			return match[2]
+		}
+		return match[1] + " <" + match[2] + ">"
+	})
+	line = mdEmphasis.ReplaceAllString(line, "$2")
+	return strings.ReplaceAll(line, "`", "")
+}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+// progressBar draws "[#####.....] 12/40" on stderr while a batch runs. It
+// only draws when stderr is a terminal, so logs and pipes stay clean.
+type progressBar struct {
+	mu     sync.Mutex
+	w      io.Writer
+	total  int
+	done   int
+	active bool
+}
+
+const progressWidth = 30
+
+func newProgressBar(total int) *progressBar {
+	bar := &progressBar{w: os.Stderr, total: total, active: stderrIsTerminal()}
+	bar.mu.Lock()
+	bar.draw()
+	bar.mu.Unlock()
+	return bar
+}
+
+// Increment marks one more item as done.
+func (b *progressBar) Increment() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.done++
+	b.draw()
+}
+
+// Printf writes a line to w above the bar.
+func (b *progressBar) Printf(w io.Writer, format string, args ...any) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.clear()
+	fmt.Fprintf(w, format, args...)
+	b.draw()
+}
+
+// Finish removes the bar.
+func (b *progressBar) Finish() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.clear()
+	b.active = false
+}
+
+func (b *progressBar) draw() {
+	if !b.active || b.total == 0 {
+		return
+	}
+	filled := progressWidth * b.done / b.total
+	fmt.Fprintf(b.w, "\r[%s%s] %d/%d", strings.Repeat("#", filled), strings.Repeat(".", progressWidth-filled), b.done, b.total)
+}
+
+func (b *progressBar) clear() {
+	if b.active {
+		fmt.Fprint(b.w, "\r\033[K")
+	}
+}
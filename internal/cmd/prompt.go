@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+var stdinReader = bufio.NewReader(os.Stdin)
+
+// prompt asks for a line of input, returning def when the answer is empty.
+func prompt(label string, def string) (string, error) {
+	if def != "" {
+		fmt.Printf("%s [%s]: ", label, def)
+	} else {
+		fmt.Printf("%s: ", label)
+	}
+	line, err := stdinReader.ReadString('\n')
+	if err != nil && line == "" {
+		return "", fmt.Errorf("failed to read input: %w", err)
+	}
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return def, nil
+	}
+	return line, nil
+}
+
+// promptSecret asks for a line of input without echoing it. Piped input is
+// read as is.
+func promptSecret(label string) (string, error) {
+	if !stdinIsTerminal() {
+		return prompt(label, "")
+	}
+	fmt.Printf("%s: ", label)
+	secret, err := term.ReadPassword(int(os.Stdin.Fd()))
+	fmt.Println()
+	if err != nil {
+		return "", fmt.Errorf("failed to read input: %w", err)
+	}
+	return strings.TrimSpace(string(secret)), nil
+}
+
+// confirm asks a yes/no question, defaulting to no.
+func confirm(question string) (bool, error) {
+	answer, err := prompt(question+" [y/N]", "")
+	if err != nil {
+		return false, err
+	}
+	answer = strings.ToLower(answer)
+	return answer == "y" || answer == "yes", nil
+}
+
+// openEditor opens path in $VISUAL or $EDITOR, falling back to vi.
+func openEditor(path string) error {
+	editor := os.Getenv("VISUAL")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	if editor == "" {
+		editor = "vi"
+	}
+	// The editor may carry its own arguments, e.g. "code --wait".
+	parts := strings.Fields(editor)
+	c := exec.Command(parts[0], append(parts[1:], path)...)
+	c.Stdin = os.Stdin
+	c.Stdout = os.Stdout
+	c.Stderr = os.Stderr
+	if err := c.Run(); err != nil {
+		return fmt.Errorf("editor %s failed: %w", editor, err)
+	}
+	return nil
+}
+
+// readBody gets markdown text for a comment or description: from the
+// --body flag ("-" reads stdin), from piped stdin, or else by opening
+// $EDITOR on initial.
+func readBody(cmd *cobra.Command, initial string) (string, error) {
+	body, _ := cmd.Flags().GetString("body")
+	if body == "-" || (!cmd.Flags().Changed("body") && !stdinIsTerminal()) {
+		raw, err := io.ReadAll(stdinReader)
+		if err != nil {
+			return "", fmt.Errorf("failed to read stdin: %w", err)
+		}
+		body = string(raw)
+	} else if !cmd.Flags().Changed("body") {
+		var err error
+		body, err = editText(initial)
+		if err != nil {
+			return "", err
+		}
+	}
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return "", errors.New("aborting: the text is empty")
+	}
+	return body, nil
+}
+
+// editText opens $EDITOR on a temporary markdown file holding initial and
+// returns what was saved.
+func editText(initial string) (string, error) {
+	f, err := os.CreateTemp("", "lineartui-*.md")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.WriteString(initial); err != nil {
+		f.Close()
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+	if err := openEditor(f.Name()); err != nil {
+		return "", err
+	}
+	raw, err := os.ReadFile(f.Name())
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
+
+func stdinIsTerminal() bool {
+	info, err := os.Stdin.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+func stderrIsTerminal() bool {
+	info, err := os.Stderr.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,169 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// relationKind maps a relation as typed on the command line to Linear's
+// relation type. Linear only stores one direction, so blocked-by is a
+// "blocks" relation created from the other issue.
+type relationKind struct {
+	apiType string
+	swap    bool
+	// verb is how relationVerb describes the relation from the first issue.
+	verb string
+}
+
+var relationKinds = map[string]relationKind{
+	"blocks":       {apiType: "blocks", verb: "blocks"},
+	"blocked-by":   {apiType: "blocks", swap: true, verb: "blocked by"},
+	"related":      {apiType: "related", verb: "related to"},
+	"duplicate-of": {apiType: "duplicate", verb: "duplicate of"},
+}
+
+func relationKindNames() string {
+	names := make([]string, 0, len(relationKinds))
+	for name := range relationKinds {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+func lookupRelationKind(name string) (relationKind, error) {
+	kind, ok := relationKinds[strings.ToLower(name)]
+	if !ok {
+		return kind, fmt.Errorf("unknown relation %q, use one of: %s", name, relationKindNames())
+	}
+	return kind, nil
+}
+
+var issuesRelateCmd = &cobra.Command{
+	Use:   "relate <issue> <relation> <issue>",
+	Short: "Link two issues",
+	Long: `Link two issues, e.g.
+
+  lineartui issues relate ENG-1 blocks ENG-2
+
+Relations: ` + relationKindNames() + `.`,
+	Args: cobra.ExactArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		kind, err := lookupRelationKind(args[1])
+		if err != nil {
+			return err
+		}
+		from, err := resolveIssue(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		to, err := resolveIssue(ctx, args[2])
+		if err != nil {
+			return err
+		}
+		if from.ID == to.ID {
+			return fmt.Errorf("an issue cannot be related to itself")
+		}
+
+		issueID, relatedID := string(from.ID), string(to.ID)
+		if kind.swap {
+			issueID, relatedID = relatedID, issueID
+		}
+		if err := linearClient.CreateIssueRelation(ctx, issueID, relatedID, kind.apiType); err != nil {
+			return err
+		}
+		if outputFormat != outputText {
+			return printRelationChanges([]relationChange{{string(from.Identifier), kind.verb, string(to.Identifier)}})
+		}
+		fmt.Printf("%s %s %s\n", from.Identifier, kind.verb, to.Identifier)
+		return nil
+	},
+}
+
+var issuesUnrelateCmd = &cobra.Command{
+	Use:   "unrelate <issue> [relation] <issue>",
+	Short: "Remove the links between two issues",
+	Long: `Remove the links between two issues. Without a relation every link
+between them is removed.
+
+Relations: ` + relationKindNames() + `.`,
+	Args: cobra.RangeArgs(2, 3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		var kind *relationKind
+		if len(args) == 3 {
+			k, err := lookupRelationKind(args[1])
+			if err != nil {
+				return err
+			}
+			kind = &k
+			args = []string{args[0], args[2]}
+		}
+		from, err := resolveIssue(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		to, err := resolveIssue(ctx, args[1])
+		if err != nil {
+			return err
+		}
+
+		relations, err := linearClient.GetIssueRelations(ctx, string(from.ID))
+		if err != nil {
+			return err
+		}
+		var removed []relationChange
+		for _, relation := range relations {
+			if relation.Issue.ID != to.ID {
+				continue
+			}
+			verb := relationVerb(string(relation.Type), relation.Inverse)
+			if kind != nil && verb != kind.verb {
+				continue
+			}
+			if err := linearClient.DeleteIssueRelation(ctx, string(relation.ID)); err != nil {
+				return err
+			}
+			if outputFormat == outputText {
+				fmt.Printf("%s no longer %s %s\n", from.Identifier, verb, to.Identifier)
+			}
+			removed = append(removed, relationChange{string(from.Identifier), verb, string(to.Identifier)})
+		}
+		if len(removed) == 0 {
+			return fmt.Errorf("%s and %s are not linked that way", from.Identifier, to.Identifier)
+		}
+		if outputFormat != outputText {
+			return printRelationChanges(removed)
+		}
+		return nil
+	},
+}
+
+// relationChange is a link relate created or unrelate removed, described
+// from the first issue.
+type relationChange struct {
+	Issue        string `json:"issue"`
+	Relation     string `json:"relation"`
+	RelatedIssue string `json:"relatedIssue"`
+}
+
+func printRelationChanges(changes []relationChange) error {
+	if outputFormat == outputJSON {
+		return printJSON(changes)
+	}
+	rows := make([][]string, 0, len(changes))
+	for _, change := range changes {
+		rows = append(rows, []string{change.Issue, change.Relation, change.RelatedIssue})
+	}
+	return printCSV([]string{"issue", "relation", "relatedIssue"}, rows)
+}
+
+func init() {
+	issuesCmd.AddCommand(issuesRelateCmd)
+	issuesCmd.AddCommand(issuesUnrelateCmd)
+}
@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/junipery17/lineartui/internal/client"
+	"github.com/spf13/cobra"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+func isUUID(s string) bool {
+	return uuidPattern.MatchString(s)
+}
+
+// resolveTeamID accepts a team ID or name. An empty value falls back to
+// defaults.team and then linear.team_id.
+func resolveTeamID(ctx context.Context, team string) (string, error) {
+	if team == "" {
+		team = cfg.Defaults.Team
+	}
+	if team == "" {
+		team = cfg.Linear.TeamID
+	}
+	if team == "" {
+		return "", fmt.Errorf("team ID required. Use --team flag or set defaults.team or linear.team_id in config")
+	}
+	if isUUID(team) {
+		return team, nil
+	}
+	return linearClient.FindTeamByName(ctx, team)
+}
+
+// resolveProjectID accepts a project ID or name.
+func resolveProjectID(ctx context.Context, project string) (string, error) {
+	if project == "" || isUUID(project) {
+		return project, nil
+	}
+	return linearClient.FindProjectByName(ctx, project)
+}
+
+// resolveAssignee turns an assignee flag into a user ID. It accepts "@me",
+// "none" to unassign (returned as ""), a user ID, an email or a name.
+func resolveAssignee(ctx context.Context, input string) (string, error) {
+	input = strings.TrimSpace(input)
+	switch strings.ToLower(input) {
+	case "":
+		return "", fmt.Errorf("assignee must not be empty, use \"none\" to unassign")
+	case "none", "unassigned":
+		return "", nil
+	case "@me", "me":
+		viewer, err := linearClient.Viewer(ctx)
+		if err != nil {
+			return "", err
+		}
+		return string(viewer.ID), nil
+	}
+	if isUUID(input) {
+		return input, nil
+	}
+
+	users, err := linearClient.SearchUsers(ctx, input)
+	if err != nil {
+		return "", err
+	}
+
+	// Prefer exact matches so "Sam" does not collide with "Samantha".
+	want := strings.ToLower(input)
+	var exact []client.UserData
+	for _, user := range users {
+		if strings.ToLower(string(user.Email)) == want ||
+			strings.ToLower(string(user.Name)) == want ||
+			strings.ToLower(string(user.DisplayName)) == want {
+			exact = append(exact, user)
+		}
+	}
+	if len(exact) > 0 {
+		users = exact
+	}
+
+	switch len(users) {
+	case 0:
+		return "", fmt.Errorf("no user matches %q", input)
+	case 1:
+		return string(users[0].ID), nil
+	}
+	candidates := make([]string, 0, len(users))
+	for _, user := range users {
+		candidates = append(candidates, fmt.Sprintf("  %s (%s) <%s>", user.Name, user.DisplayName, user.Email))
+	}
+	return "", fmt.Errorf("%q matches %d users, be more specific:\n%s", input, len(users), strings.Join(candidates, "\n"))
+}
+
+var (
+	identifierPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*-[0-9]+$`)
+	issueURLPattern   = regexp.MustCompile(`linear\.app/[^/]+/issue/([A-Za-z][A-Za-z0-9_]*-[0-9]+)`)
+)
+
+// parseIssueRef normalizes a UUID, a team-key identifier such as ENG-123,
+// or a linear.app issue URL into a value the API accepts as an issue ID.
+func parseIssueRef(ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	switch {
+	case isUUID(ref):
+		return strings.ToLower(ref), nil
+	case identifierPattern.MatchString(ref):
+		return strings.ToUpper(ref), nil
+	}
+	if match := issueURLPattern.FindStringSubmatch(ref); match != nil {
+		return strings.ToUpper(match[1]), nil
+	}
+	return "", fmt.Errorf("%q is not an issue ID, identifier (ENG-123) or Linear issue URL", ref)
+}
+
+// resolveIssue parses ref and looks the issue up, so callers have both its
+// UUID for mutations and its identifier for output.
+func resolveIssue(ctx context.Context, ref string) (*client.IssueRef, error) {
+	id, err := parseIssueRef(ref)
+	if err != nil {
+		return nil, err
+	}
+	issue, err := linearClient.GetIssueRef(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if issue.ID == "" {
+		return nil, fmt.Errorf("issue %s not found", ref)
+	}
+	return issue, nil
+}
+
+// issueFromArgs resolves the issue a command operates on from its
+// positional argument or the legacy --issueID and --titleSearch flags.
+func issueFromArgs(cmd *cobra.Command, args []string) (*client.IssueRef, error) {
+	ctx := context.Background()
+	ref := ""
+	if len(args) > 0 {
+		ref = args[0]
+	}
+	if flag := cmd.Flags().Lookup("issueID"); flag != nil && flag.Changed {
+		ref = flag.Value.String()
+	}
+	if flag := cmd.Flags().Lookup("titleSearch"); flag != nil && flag.Changed {
+		var err error
+		ref, err = linearClient.FindIssueByTitle(ctx, cfg.Linear.TeamID, flag.Value.String())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if ref == "" {
+		return nil, fmt.Errorf("an issue is required: pass an ID, identifier (ENG-123) or URL")
+	}
+	return resolveIssue(ctx, ref)
+}
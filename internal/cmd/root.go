@@ -22,22 +22,48 @@ var rootCmd = &cobra.Command{
 	Long:  `lineartui is a terminal user interface for interacting with Linear project management.`,
 
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
-		var err error
-		cfg, err = config.New()
-		if err != nil {
-			return fmt.Errorf("failed to load config: %w", err)
+		if err := loadConfig(); err != nil {
+			return err
 		}
 
-		if cfg.Linear.APIKey == "" {
-			return fmt.Errorf("Missing API key in configuration chain.")
+		apiKey, err := cfg.ResolveAPIKey()
+		if err != nil {
+			return err
+		}
+		if apiKey != "" {
+			linearClient = client.NewClient(apiKey, cfg.Linear.APIURL)
+			return nil
 		}
 
-		linearClient = client.NewClient(cfg.Linear.APIKey, cfg.Linear.APIURL)
+		// Without an API key fall back to a stored OAuth login.
+		token, err := tokenStore().Load()
+		if err != nil {
+			return err
+		}
+		if token == nil {
+			return fmt.Errorf("Missing API key in configuration chain. Set LCLI_LINEAR_API_KEY or run 'lineartui auth login'")
+		}
+		linearClient = newOAuthClient()
 
 		return nil
 	},
 }
 
+func loadConfig() error {
+	if err := checkOutputFormat(); err != nil {
+		return err
+	}
+	var err error
+	cfg, err = config.New(cfgFile)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	for _, warning := range cfg.Warnings() {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", warning)
+	}
+	return nil
+}
+
 func Execute() {
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintln(os.Stderr, err)
@@ -46,5 +72,6 @@ func Execute() {
 }
 
 func init() {
-	rootCmd.PersistentFlags().StringVar(&cfgFile, "configfile", "", "config file (default is ./.lcli.yaml or $HOME/.lcli.yaml)")
+	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", outputText, "Output format: text, json or csv")
+	rootCmd.PersistentFlags().StringVar(&cfgFile, "configfile", "", "config file (default is layered: /etc/lineartui/config.yaml, $XDG_CONFIG_HOME/lineartui/config.yaml, $HOME/.lcli.yaml, .lcli.yaml up to the git root)")
 }
@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/junipery17/lineartui/internal/client"
+	"github.com/spf13/cobra"
+)
+
+// stateTypes are Linear's fixed workflow state categories. Any of them can
+// be given instead of a state name to pick the first state of that type.
+var stateTypes = []string{"triage", "backlog", "unstarted", "started", "completed", "canceled"}
+
+var statesCmd = &cobra.Command{
+	Use:   "states",
+	Short: "Inspect workflow states",
+	Long:  `List the workflow states issues can move through for a team.`,
+}
+
+var statesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List workflow states for a team",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		team, _ := cmd.Flags().GetString("team")
+		teamID, err := resolveTeamID(ctx, team)
+		if err != nil {
+			return err
+		}
+		states, err := linearClient.GetWorkflowStates(ctx, teamID)
+		if err != nil {
+			return err
+		}
+		switch outputFormat {
+		case outputJSON:
+			if states == nil {
+				states = []client.WorkflowStateData{}
+			}
+			return printJSON(states)
+		case outputCSV:
+			rows := make([][]string, 0, len(states))
+			for _, state := range states {
+				rows = append(rows, []string{string(state.ID), string(state.Name), string(state.Type)})
+			}
+			return printCSV([]string{"id", "name", "type"}, rows)
+		}
+		for _, state := range states {
+			fmt.Printf("%-20s %-10s %s\n", state.Name, state.Type, state.ID)
+		}
+		return nil
+	},
+}
+
+// resolveState finds the workflow state named input for the issue's team.
+func resolveState(ctx context.Context, issueID string, input string) (*client.WorkflowStateData, error) {
+	teamID, err := linearClient.GetIssueTeamID(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+	states, err := linearClient.GetWorkflowStates(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	return matchState(states, input)
+}
+
+// matchState matches input against state names case-insensitively, then
+// against state types. Unknown input is rejected with suggestions and the
+// list of valid states.
+func matchState(states []client.WorkflowStateData, input string) (*client.WorkflowStateData, error) {
+	want := strings.ToLower(strings.TrimSpace(input))
+	for i, state := range states {
+		if strings.ToLower(string(state.Name)) == want {
+			return &states[i], nil
+		}
+	}
+	for _, stateType := range stateTypes {
+		if stateType != want {
+			continue
+		}
+		for i, state := range states {
+			if string(state.Type) == stateType {
+				return &states[i], nil
+			}
+		}
+		return nil, fmt.Errorf("team has no state of type %q", want)
+	}
+
+	names := make([]string, 0, len(states))
+	var suggestions []string
+	for _, state := range states {
+		name := string(state.Name)
+		names = append(names, name)
+		lower := strings.ToLower(name)
+		if strings.Contains(lower, want) || levenshtein(lower, want) <= 2 {
+			suggestions = append(suggestions, name)
+		}
+	}
+	msg := fmt.Sprintf("unknown state %q", input)
+	if len(suggestions) > 0 {
+		msg += fmt.Sprintf(", did you mean %s?", strings.Join(quoteAll(suggestions), " or "))
+	} else {
+		msg += "."
+	}
+	return nil, fmt.Errorf("%s Valid states: %s (or a type: %s)", msg, strings.Join(names, ", "), strings.Join(stateTypes, ", "))
+}
+
+func quoteAll(items []string) []string {
+	quoted := make([]string, len(items))
+	for i, item := range items {
+		quoted[i] = fmt.Sprintf("%q", item)
+	}
+	return quoted
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+func init() {
+	rootCmd.AddCommand(statesCmd)
+	statesCmd.AddCommand(statesListCmd)
+
+	statesListCmd.Flags().StringP("team", "t", "", "Team name or ID to list states for")
+}
@@ -12,10 +12,35 @@ var teamsCmd = &cobra.Command{
 	Long:  `Display all teams you have access to in Linear.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := context.Background()
-		return linearClient.DisplayTeams(ctx)
+		if outputFormat == outputText {
+			return linearClient.DisplayTeams(ctx)
+		}
+		teams, err := linearClient.GetTeams(ctx)
+		if err != nil {
+			return err
+		}
+		// TeamData carries the team's issues too; only the ID and name are
+		// printed.
+		rows := make([]teamRow, 0, len(teams))
+		for _, team := range teams {
+			rows = append(rows, teamRow{ID: string(team.ID), Name: string(team.Name)})
+		}
+		if outputFormat == outputJSON {
+			return printJSON(rows)
+		}
+		csvRows := make([][]string, 0, len(rows))
+		for _, row := range rows {
+			csvRows = append(csvRows, []string{row.ID, row.Name})
+		}
+		return printCSV([]string{"id", "name"}, csvRows)
 	},
 }
 
+type teamRow struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
 func init() {
 	rootCmd.AddCommand(teamsCmd)
 }
@@ -0,0 +1,345 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/junipery17/lineartui/internal/client"
+	"github.com/junipery17/lineartui/internal/config"
+	"github.com/spf13/cobra"
+	"go.yaml.in/yaml/v3"
+)
+
+var templatesCmd = &cobra.Command{
+	Use:   "templates",
+	Short: "Issue templates",
+	Long: `List templates usable with 'issues create --template'.
+
+Local templates are YAML files named <name>.yaml in the repo's
+.lineartui/templates directory or in ~/.config/lineartui/templates; the
+repo's copy wins when both have the same name:
+
+  about: Something is broken
+  title_prefix: "[Bug] "
+  description: |
+    ## Steps to reproduce
+    {{.Steps}}
+
+    ## Expected
+    {{.Expected}}
+  labels: [bug]
+  priority: high
+  sub_issues:
+    - title: "Add a regression test for {{.Area}}"
+      labels: [test]
+
+Placeholders such as {{.Steps}} are filled from --var Steps=... or asked
+for. Templates stored in the Linear workspace are used by name or ID.`,
+}
+
+var templatesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List local and workspace issue templates",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		local, err := loadIssueTemplates()
+		if err != nil {
+			return err
+		}
+		// Local templates are still worth listing when Linear cannot be
+		// reached or the token lacks the scope.
+		workspace, err := workspaceIssueTemplates(context.Background())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "warning: cannot list workspace templates: %s\n", err)
+		}
+
+		switch outputFormat {
+		case outputJSON:
+			return printJSON(map[string]any{"local": local, "workspace": workspace})
+		case outputCSV:
+			var rows [][]string
+			for _, t := range local {
+				rows = append(rows, []string{"local", t.Name, t.About, t.Path})
+			}
+			for _, t := range workspace {
+				rows = append(rows, []string{"workspace", string(t.Name), string(t.Description), string(t.ID)})
+			}
+			return printCSV([]string{"source", "name", "description", "id"}, rows)
+		}
+
+		if len(local) > 0 {
+			fmt.Println("Local templates:")
+			for _, t := range local {
+				fmt.Printf("  %-20s %s\n", t.Name, t.About)
+			}
+		}
+		if len(workspace) > 0 {
+			fmt.Println("Workspace templates:")
+			for _, t := range workspace {
+				scope := "workspace"
+				if t.Team != nil {
+					scope = string(t.Team.Key)
+				}
+				fmt.Printf("  %-20s %-10s %s\n", t.Name, scope, t.Description)
+			}
+		}
+		if len(local) == 0 && len(workspace) == 0 {
+			fmt.Println("No templates found.")
+		}
+		return nil
+	},
+}
+
+// issueTemplate is a local template file. LinearID is set instead when the
+// template is one stored in the workspace, which Linear fills in itself.
+type issueTemplate struct {
+	Name        string             `yaml:"-" json:"name"`
+	Path        string             `yaml:"-" json:"path,omitempty"`
+	LinearID    string             `yaml:"-" json:"-"`
+	About       string             `yaml:"about" json:"about,omitempty"`
+	Team        string             `yaml:"team" json:"team,omitempty"`
+	TitlePrefix string             `yaml:"title_prefix" json:"titlePrefix,omitempty"`
+	Description string             `yaml:"description" json:"description,omitempty"`
+	Labels      []string           `yaml:"labels" json:"labels,omitempty"`
+	Priority    string             `yaml:"priority" json:"priority,omitempty"`
+	SubIssues   []templateSubIssue `yaml:"sub_issues" json:"subIssues,omitempty"`
+}
+
+type templateSubIssue struct {
+	Title       string   `yaml:"title" json:"title"`
+	Description string   `yaml:"description" json:"description,omitempty"`
+	Labels      []string `yaml:"labels" json:"labels,omitempty"`
+	Priority    string   `yaml:"priority" json:"priority,omitempty"`
+}
+
+// loadIssueTemplates reads every template in the template directories,
+// sorted by name.
+func loadIssueTemplates() ([]*issueTemplate, error) {
+	var templates []*issueTemplate
+	seen := make(map[string]bool)
+	for _, dir := range config.TemplateDirs() {
+		entries, err := os.ReadDir(dir)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read templates: %w", err)
+		}
+		for _, entry := range entries {
+			ext := filepath.Ext(entry.Name())
+			name := strings.TrimSuffix(entry.Name(), ext)
+			if entry.IsDir() || (ext != ".yaml" && ext != ".yml") || seen[strings.ToLower(name)] {
+				continue
+			}
+			t, err := readIssueTemplate(filepath.Join(dir, entry.Name()))
+			if err != nil {
+				return nil, err
+			}
+			seen[strings.ToLower(name)] = true
+			templates = append(templates, t)
+		}
+	}
+	sort.Slice(templates, func(i, j int) bool { return templates[i].Name < templates[j].Name })
+	return templates, nil
+}
+
+func readIssueTemplate(path string) (*issueTemplate, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template: %w", err)
+	}
+	t := &issueTemplate{}
+	if err := yaml.Unmarshal(raw, t); err != nil {
+		return nil, fmt.Errorf("failed to parse template %s: %w", path, err)
+	}
+	t.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	t.Path = path
+	for _, sub := range t.SubIssues {
+		if strings.TrimSpace(sub.Title) == "" {
+			return nil, fmt.Errorf("template %s has a sub-issue without a title", path)
+		}
+	}
+	return t, nil
+}
+
+// workspaceIssueTemplates lists the issue templates stored in Linear.
+func workspaceIssueTemplates(ctx context.Context) ([]client.TemplateData, error) {
+	templates, err := linearClient.ListTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	issueTemplates := templates[:0]
+	for _, t := range templates {
+		if t.Type == "issue" {
+			issueTemplates = append(issueTemplates, t)
+		}
+	}
+	sort.Slice(issueTemplates, func(i, j int) bool { return issueTemplates[i].Name < issueTemplates[j].Name })
+	return issueTemplates, nil
+}
+
+// findIssueTemplate looks up a local template by case-insensitive name,
+// falling back to a workspace template by ID or name.
+func findIssueTemplate(ctx context.Context, name string) (*issueTemplate, error) {
+	local, err := loadIssueTemplates()
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, t := range local {
+		if strings.EqualFold(t.Name, name) {
+			return t, nil
+		}
+		names = append(names, t.Name)
+	}
+	workspace, err := workspaceIssueTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range workspace {
+		if string(t.ID) == name || strings.EqualFold(string(t.Name), name) {
+			found := &issueTemplate{Name: string(t.Name), LinearID: string(t.ID)}
+			if t.Team != nil {
+				found.Team = string(t.Team.ID)
+			}
+			return found, nil
+		}
+		names = append(names, string(t.Name))
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no template named %q, and no templates are defined", name)
+	}
+	return nil, fmt.Errorf("no template named %q, available templates: %s", name, strings.Join(names, ", "))
+}
+
+// render fills the template's placeholders from vars, given as name=value.
+// Placeholders without a value are asked for on a terminal.
+func (t *issueTemplate) render(vars []string) (*issueTemplate, error) {
+	values := make(map[string]string)
+	for _, v := range vars {
+		name, value, ok := strings.Cut(v, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid --var %q, use name=value", v)
+		}
+		values[name] = value
+	}
+
+	texts := []string{t.TitlePrefix, t.Description}
+	for _, sub := range t.SubIssues {
+		texts = append(texts, sub.Title, sub.Description)
+	}
+	var needed []string
+	for _, text := range texts {
+		tmpl, err := template.New(t.Name).Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("template %s: %w", t.Name, err)
+		}
+		if tmpl.Tree != nil {
+			templateVars(tmpl.Tree.Root, &needed)
+		}
+	}
+	for name := range values {
+		if !slices.Contains(needed, name) {
+			return nil, fmt.Errorf("template %s has no variable %s, its variables are: %s", t.Name, name, strings.Join(needed, ", "))
+		}
+	}
+	for _, name := range needed {
+		if _, ok := values[name]; ok {
+			continue
+		}
+		if !stdinIsTerminal() {
+			return nil, fmt.Errorf("template %s needs a value for %s, pass --var %s=...", t.Name, name, name)
+		}
+		value, err := prompt(name, "")
+		if err != nil {
+			return nil, err
+		}
+		values[name] = value
+	}
+
+	rendered := *t
+	rendered.SubIssues = slices.Clone(t.SubIssues)
+	fill := func(text *string) error {
+		tmpl, err := template.New(t.Name).Option("missingkey=error").Parse(*text)
+		if err != nil {
+			return err
+		}
+		var b strings.Builder
+		if err := tmpl.Execute(&b, values); err != nil {
+			return fmt.Errorf("template %s: %w", t.Name, err)
+		}
+		*text = b.String()
+		return nil
+	}
+	if err := fill(&rendered.TitlePrefix); err != nil {
+		return nil, err
+	}
+	if err := fill(&rendered.Description); err != nil {
+		return nil, err
+	}
+	for i := range rendered.SubIssues {
+		if err := fill(&rendered.SubIssues[i].Title); err != nil {
+			return nil, err
+		}
+		if err := fill(&rendered.SubIssues[i].Description); err != nil {
+			return nil, err
+		}
+	}
+	return &rendered, nil
+}
+
+// templateVars appends the top-level field names used in node, such as
+// Steps for {{.Steps}}, in order of first use.
+func templateVars(node parse.Node, names *[]string) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			templateVars(child, names)
+		}
+	case *parse.ActionNode:
+		templateVars(n.Pipe, names)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, c := range n.Cmds {
+			templateVars(c, names)
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			templateVars(arg, names)
+		}
+	case *parse.FieldNode:
+		if !slices.Contains(*names, n.Ident[0]) {
+			*names = append(*names, n.Ident[0])
+		}
+	case *parse.IfNode:
+		templateBranchVars(&n.BranchNode, names)
+	case *parse.RangeNode:
+		templateBranchVars(&n.BranchNode, names)
+	case *parse.WithNode:
+		templateBranchVars(&n.BranchNode, names)
+	}
+}
+
+func templateBranchVars(n *parse.BranchNode, names *[]string) {
+	templateVars(n.Pipe, names)
+	templateVars(n.List, names)
+	templateVars(n.ElseList, names)
+}
+
+func init() {
+	rootCmd.AddCommand(templatesCmd)
+	templatesCmd.AddCommand(templatesListCmd)
+}
@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/junipery17/lineartui/internal/client"
+	"github.com/spf13/cobra"
+)
+
+var issuesStartCmd = newTransitionCmd("start", "Move issues to the first started state")
+var issuesReviewCmd = newTransitionCmd("review", "Move issues to the team's review state")
+var issuesDoneCmd = newTransitionCmd("done", "Move issues to the first completed state")
+var issuesCancelCmd = newTransitionCmd("cancel", "Move issues to the first canceled state")
+
+// newTransitionCmd builds a verb command that works across custom workflows
+// by targeting a state type rather than a state name.
+func newTransitionCmd(verb string, short string) *cobra.Command {
+	return &cobra.Command{
+		Use:   verb + " <issue>...",
+		Short: short,
+		Long: short + `.
+
+The target state can be overridden per team in the config:
+
+  transitions:
+    ENG:
+      ` + verb + `: "State Name"`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			failed := 0
+			moves := []transitionMove{}
+			for _, ref := range args {
+				move, err := transitionIssue(ctx, ref, verb)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "%s: %s\n", ref, err)
+					failed++
+					continue
+				}
+				if outputFormat == outputText {
+					printTransitionMove(move)
+					continue
+				}
+				moves = append(moves, *move)
+			}
+			if outputFormat != outputText {
+				if err := printTransitionMoves(moves); err != nil {
+					return err
+				}
+			}
+			if failed > 0 {
+				return fmt.Errorf("%d of %d issues could not be moved", failed, len(args))
+			}
+			return nil
+		},
+	}
+}
+
+// transitionMove records the state change of one issue. Moved is false
+// when the issue was already in the target state.
+type transitionMove struct {
+	Identifier string `json:"identifier"`
+	From       string `json:"from"`
+	To         string `json:"to"`
+	Moved      bool   `json:"moved"`
+}
+
+func transitionIssue(ctx context.Context, ref string, verb string) (*transitionMove, error) {
+	issueID, err := parseIssueRef(ref)
+	if err != nil {
+		return nil, err
+	}
+	issue, err := linearClient.GetIssueState(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+	states, err := linearClient.GetWorkflowStates(ctx, string(issue.Team.ID))
+	if err != nil {
+		return nil, err
+	}
+	target, err := transitionTarget(states, string(issue.Team.Key), string(issue.Team.ID), verb)
+	if err != nil {
+		return nil, err
+	}
+
+	move := &transitionMove{Identifier: string(issue.Identifier), From: string(issue.State.Name), To: string(target.Name)}
+	if target.ID == issue.State.ID {
+		return move, nil
+	}
+	if err := linearClient.UpdateStatusOnIssue(ctx, string(issue.ID), string(target.ID)); err != nil {
+		return nil, err
+	}
+	move.Moved = true
+	return move, nil
+}
+
+func printTransitionMove(move *transitionMove) {
+	if !move.Moved {
+		fmt.Printf("%s: already %s\n", move.Identifier, move.From)
+		return
+	}
+	fmt.Printf("%s: %s → %s\n", move.Identifier, move.From, move.To)
+}
+
+func printTransitionMoves(moves []transitionMove) error {
+	if outputFormat == outputJSON {
+		return printJSON(moves)
+	}
+	rows := make([][]string, 0, len(moves))
+	for _, move := range moves {
+		rows = append(rows, []string{move.Identifier, move.From, move.To, strconv.FormatBool(move.Moved)})
+	}
+	return printCSV([]string{"identifier", "from", "to", "moved"}, rows)
+}
+
+// transitionTarget picks the state a verb moves to: the configured override
+// if any, otherwise the first state of the verb's type.
+func transitionTarget(states []client.WorkflowStateData, teamKey string, teamID string, verb string) (*client.WorkflowStateData, error) {
+	if override := cfg.Transition(teamKey, teamID, verb); override != "" {
+		return matchState(states, override)
+	}
+
+	switch verb {
+	case "start":
+		return matchState(states, "started")
+	case "done":
+		return matchState(states, "completed")
+	case "cancel":
+		return matchState(states, "canceled")
+	case "review":
+		for i, state := range states {
+			if string(state.Type) == "started" && strings.Contains(strings.ToLower(string(state.Name)), "review") {
+				return &states[i], nil
+			}
+		}
+		return nil, fmt.Errorf("team %s has no review state, set transitions.%s.review in the config", teamKey, teamKey)
+	}
+	return nil, fmt.Errorf("unknown transition %q", verb)
+}
+
+func init() {
+	issuesCmd.AddCommand(issuesStartCmd)
+	issuesCmd.AddCommand(issuesReviewCmd)
+	issuesCmd.AddCommand(issuesDoneCmd)
+	issuesCmd.AddCommand(issuesCancelCmd)
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/junipery17/lineartui/internal/client"
+	"github.com/spf13/cobra"
+)
+
+var usersCmd = &cobra.Command{
+	Use:   "users",
+	Short: "Find Linear users",
+	Long:  `List and search the members of your Linear workspace.`,
+}
+
+var usersListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List active users",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		users, err := linearClient.ListUsers(context.Background())
+		if err != nil {
+			return err
+		}
+		return printUsers(users)
+	},
+}
+
+var usersSearchCmd = &cobra.Command{
+	Use:   "search <term>",
+	Short: "Search users by name, display name or email",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		users, err := linearClient.SearchUsers(context.Background(), args[0])
+		if err != nil {
+			return err
+		}
+		if len(users) == 0 {
+			return fmt.Errorf("no user matches %q", args[0])
+		}
+		return printUsers(users)
+	},
+}
+
+var usersMeCmd = &cobra.Command{
+	Use:   "me",
+	Short: "Show the authenticated user",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		viewer, err := linearClient.Viewer(context.Background())
+		if err != nil {
+			return err
+		}
+		return printUsers([]client.UserData{*viewer})
+	},
+}
+
+func printUsers(users []client.UserData) error {
+	switch outputFormat {
+	case outputJSON:
+		if users == nil {
+			users = []client.UserData{}
+		}
+		return printJSON(users)
+	case outputCSV:
+		rows := make([][]string, 0, len(users))
+		for _, user := range users {
+			rows = append(rows, []string{string(user.ID), string(user.Name), string(user.DisplayName), string(user.Email)})
+		}
+		return printCSV([]string{"id", "name", "displayName", "email"}, rows)
+	}
+	for _, user := range users {
+		fmt.Printf("%-24s %-16s %-32s %s\n", user.Name, user.DisplayName, user.Email, user.ID)
+	}
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(usersCmd)
+	usersCmd.AddCommand(usersListCmd)
+	usersCmd.AddCommand(usersSearchCmd)
+	usersCmd.AddCommand(usersMeCmd)
+}
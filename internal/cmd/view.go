@@ -0,0 +1,241 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/junipery17/lineartui/internal/client"
+	"github.com/spf13/cobra"
+)
+
+var issuesViewCmd = &cobra.Command{
+	Use:   "view <issue>",
+	Short: "Show every field of an issue",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		issueID, err := parseIssueRef(args[0])
+		if err != nil {
+			return err
+		}
+		issue, err := linearClient.GetIssue(context.Background(), issueID)
+		if err != nil {
+			return err
+		}
+		switch outputFormat {
+		case outputJSON:
+			return printJSON(issue)
+		case outputCSV:
+			// CSV is read by programs, so timestamps are absolute RFC3339
+			// rather than local and relative.
+			stamp := func(t time.Time) string { return exportTime(&t) }
+			return printCSV([]string{"field", "value"}, issueFields(issue, stamp))
+		}
+		printIssue(issue)
+		return nil
+	},
+}
+
+// issueFields flattens an issue into field/value pairs, shared by the text
+// and CSV renderings and the Markdown export. stamp formats timestamps.
+func issueFields(issue *client.IssueDetail, stamp func(time.Time) string) [][]string {
+	fields := [][]string{
+		{"identifier", string(issue.Identifier)},
+		{"title", string(issue.Title)},
+		{"url", string(issue.URL)},
+		{"team", string(issue.Team.Name)},
+		{"state", fmt.Sprintf("%s (%s)", issue.State.Name, issue.State.Type)},
+		{"priority", string(issue.PriorityLabel)},
+	}
+	if issue.Estimate != nil {
+		fields = append(fields, []string{"estimate", fmt.Sprint(float64(*issue.Estimate))})
+	}
+	if issue.DueDate != nil {
+		fields = append(fields, []string{"due", string(*issue.DueDate)})
+	}
+	if issue.Assignee != nil {
+		fields = append(fields, []string{"assignee", formatUser(issue.Assignee)})
+	}
+	if issue.Creator != nil {
+		fields = append(fields, []string{"creator", formatUser(issue.Creator)})
+	}
+	if labels := issueLabelNames(issue); len(labels) > 0 {
+		fields = append(fields, []string{"labels", strings.Join(labels, ", ")})
+	}
+	if issue.Project != nil {
+		fields = append(fields, []string{"project", string(issue.Project.Name)})
+	}
+	if issue.Cycle != nil {
+		cycle := fmt.Sprintf("#%d", int(issue.Cycle.Number))
+		if issue.Cycle.Name != "" {
+			cycle = fmt.Sprintf("%s (%s)", issue.Cycle.Name, cycle)
+		}
+		fields = append(fields, []string{"cycle", cycle})
+	}
+	if issue.Parent != nil {
+		fields = append(fields, []string{"parent", formatIssueRef(issue.Parent)})
+	}
+	for _, child := range issue.Children.Nodes {
+		fields = append(fields, []string{"child", formatIssueRef(&child)})
+	}
+	for _, relation := range issueRelations(issue) {
+		fields = append(fields, []string{"relation", relation})
+	}
+	for _, attachment := range issue.Attachments.Nodes {
+		fields = append(fields, []string{"attachment", fmt.Sprintf("%s %s", attachment.Title, attachment.URL)})
+	}
+	fields = append(fields,
+		[]string{"created", stamp(issue.CreatedAt)},
+		[]string{"updated", stamp(issue.UpdatedAt)},
+	)
+	for _, ts := range []struct {
+		name string
+		at   *time.Time
+	}{
+		{"started", issue.StartedAt},
+		{"completed", issue.CompletedAt},
+		{"canceled", issue.CanceledAt},
+		{"archived", issue.ArchivedAt},
+	} {
+		if ts.at != nil {
+			fields = append(fields, []string{ts.name, stamp(*ts.at)})
+		}
+	}
+	return fields
+}
+
+func printIssue(issue *client.IssueDetail) {
+	fmt.Printf("%s  %s\n%s\n\n", issue.Identifier, issue.Title, issue.URL)
+	// Identifier, title and URL are in the header already.
+	for _, field := range issueFields(issue, formatTimestamp)[3:] {
+		fmt.Printf("%-11s %s\n", field[0]+":", field[1])
+	}
+
+	if issue.Description != "" {
+		fmt.Printf("\nDescription\n%s\n", indent(string(issue.Description), "  "))
+	}
+
+	if len(issue.Comments.Nodes) > 0 {
+		fmt.Printf("\nComments (%d)\n", len(issue.Comments.Nodes))
+		for _, comment := range issue.Comments.Nodes {
+			author := "Unknown"
+			if comment.User != nil {
+				author = string(comment.User.Name)
+			}
+			fmt.Printf("  %s, %s:\n%s\n", author, relativeTime(comment.CreatedAt), indent(renderMarkdown(string(comment.Body)), "    "))
+		}
+	}
+
+	if len(issue.History.Nodes) > 0 {
+		fmt.Println("\nHistory")
+		for _, entry := range issue.History.Nodes {
+			for _, change := range historyChanges(entry) {
+				actor := "Linear"
+				if entry.Actor != nil {
+					actor = string(entry.Actor.Name)
+				}
+				fmt.Printf("  %-14s %-20s %s\n", relativeTime(entry.CreatedAt), actor, change)
+			}
+		}
+	}
+}
+
+func historyChanges(entry client.IssueHistoryData) []string {
+	var changes []string
+	if entry.FromState != nil || entry.ToState != nil {
+		changes = append(changes, fmt.Sprintf("state %s → %s", nameOrNone(entry.FromState), nameOrNone(entry.ToState)))
+	}
+	if entry.FromAssignee != nil || entry.ToAssignee != nil {
+		changes = append(changes, fmt.Sprintf("assignee %s → %s", nameOrNone(entry.FromAssignee), nameOrNone(entry.ToAssignee)))
+	}
+	if entry.FromPriority != nil && entry.ToPriority != nil && *entry.FromPriority != *entry.ToPriority {
+		changes = append(changes, fmt.Sprintf("priority %s → %s", priorityName(float64(*entry.FromPriority)), priorityName(float64(*entry.ToPriority))))
+	}
+	if entry.FromTitle != nil && entry.ToTitle != nil {
+		changes = append(changes, fmt.Sprintf("title %q → %q", *entry.FromTitle, *entry.ToTitle))
+	}
+	return changes
+}
+
+func issueRelations(issue *client.IssueDetail) []string {
+	var relations []string
+	for _, relation := range issue.Relations.Nodes {
+		relations = append(relations, fmt.Sprintf("%s %s", relationVerb(string(relation.Type), false), formatIssueRef(&relation.RelatedIssue)))
+	}
+	for _, relation := range issue.InverseRelations.Nodes {
+		relations = append(relations, fmt.Sprintf("%s %s", relationVerb(string(relation.Type), true), formatIssueRef(&relation.Issue)))
+	}
+	return relations
+}
+
+// relationVerb describes a relation from the point of view of the issue
+// being viewed; inverse relations point at it from the other issue.
+func relationVerb(relationType string, inverse bool) string {
+	switch relationType {
+	case "blocks":
+		if inverse {
+			return "blocked by"
+		}
+		return "blocks"
+	case "duplicate":
+		if inverse {
+			return "duplicated by"
+		}
+		return "duplicate of"
+	case "related":
+		return "related to"
+	}
+	return relationType
+}
+
+func issueLabelNames(issue *client.IssueDetail) []string {
+	names := make([]string, 0, len(issue.Labels.Nodes))
+	for _, label := range issue.Labels.Nodes {
+		names = append(names, string(label.Name))
+	}
+	return names
+}
+
+var priorityNames = []string{"No priority", "Urgent", "High", "Medium", "Low"}
+
+func priorityName(priority float64) string {
+	if p := int(priority); p >= 0 && p < len(priorityNames) {
+		return priorityNames[p]
+	}
+	return fmt.Sprint(priority)
+}
+
+func formatUser(user *client.UserRef) string {
+	if user.Email == "" {
+		return string(user.Name)
+	}
+	return fmt.Sprintf("%s <%s>", user.Name, user.Email)
+}
+
+func formatIssueRef(issue *client.IssueRef) string {
+	return fmt.Sprintf("%s [%s] %s", issue.Identifier, issue.State.Name, issue.Title)
+}
+
+func formatTimestamp(t time.Time) string {
+	return fmt.Sprintf("%s (%s)", t.Local().Format("2006-01-02 15:04"), relativeTime(t))
+}
+
+func nameOrNone(ref *client.NameRef) string {
+	if ref == nil {
+		return "none"
+	}
+	return string(ref.Name)
+}
+
+func indent(text string, prefix string) string {
+	lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = prefix + line
+	}
+	return strings.Join(lines, "\n")
+}
+
+func init() {
+	issuesCmd.AddCommand(issuesViewCmd)
+}
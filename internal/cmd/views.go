@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/junipery17/lineartui/internal/client"
+	"github.com/spf13/cobra"
+)
+
+var viewsCmd = &cobra.Command{
+	Use:   "views",
+	Short: "Saved issue views",
+	Long: `List saved views usable with 'issues list --view'.
+
+Local views are filter expressions in the config:
+
+  views:
+    mybugs: "assignee:@me label:bug state:!done"
+
+Linear custom views from the workspace run with their own filter and sort.`,
+}
+
+var viewsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List local and workspace views",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		views, err := linearClient.ListCustomViews(context.Background())
+		if err != nil {
+			return err
+		}
+
+		switch outputFormat {
+		case outputJSON:
+			return printJSON(map[string]any{"local": cfg.Views, "workspace": views})
+		case outputCSV:
+			var rows [][]string
+			for _, name := range localViewNames() {
+				rows = append(rows, []string{"local", name, cfg.Views[name], ""})
+			}
+			for _, view := range views {
+				rows = append(rows, []string{"workspace", string(view.Name), string(view.Description), string(view.ID)})
+			}
+			return printCSV([]string{"source", "name", "description", "id"}, rows)
+		}
+
+		if len(cfg.Views) > 0 {
+			fmt.Println("Local views:")
+			for _, name := range localViewNames() {
+				fmt.Printf("  %-20s %s\n", name, cfg.Views[name])
+			}
+		}
+		if len(views) > 0 {
+			fmt.Println("Workspace views:")
+			for _, view := range views {
+				scope := "personal"
+				if view.Shared {
+					scope = "shared"
+				}
+				if view.Team != nil {
+					scope += ", " + string(view.Team.Key)
+				}
+				fmt.Printf("  %-20s %-16s %s\n", view.Name, scope, view.Description)
+			}
+		}
+		if len(cfg.Views) == 0 && len(views) == 0 {
+			fmt.Println("No views found.")
+		}
+		return nil
+	},
+}
+
+func localViewNames() []string {
+	names := make([]string, 0, len(cfg.Views))
+	for name := range cfg.Views {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// findCustomView looks up a workspace view by ID or case-insensitive name.
+func findCustomView(ctx context.Context, name string) (*client.CustomViewData, error) {
+	views, err := linearClient.ListCustomViews(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for i, view := range views {
+		if string(view.ID) == name || strings.EqualFold(string(view.Name), name) {
+			return &views[i], nil
+		}
+		names = append(names, string(view.Name))
+	}
+	names = append(names, localViewNames()...)
+	return nil, fmt.Errorf("no view named %q, available views: %s", name, strings.Join(names, ", "))
+}
+
+func init() {
+	rootCmd.AddCommand(viewsCmd)
+	viewsCmd.AddCommand(viewsListCmd)
+}
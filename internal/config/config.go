@@ -1,53 +1,437 @@
 package config
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/spf13/viper"
 )
 
+// Both prefixes are accepted; LINEARTUI_ wins when both are set.
+var envPrefixes = []string{"LCLI", "LINEARTUI"}
+
 type Config struct {
-	Linear LinearConfig
+	Linear   LinearConfig   `mapstructure:"linear"`
+	OAuth    OAuthConfig    `mapstructure:"oauth"`
+	Defaults DefaultsConfig `mapstructure:"defaults"`
+	// Transitions is keyed by team key or ID, or "default" for all teams.
+	Transitions map[string]TransitionConfig `mapstructure:"transitions"`
+	// Views maps a view name to a filter expression for `issues list --view`.
+	Views map[string]string `mapstructure:"views"`
+
+	v        *viper.Viper
+	origins  map[string]string
+	repoFile string
 }
 
 type LinearConfig struct {
-	APIKey string
-	APIURL string
-	TeamID string
+	APIKey        string `mapstructure:"api_key"`
+	APIKeyCommand string `mapstructure:"api_key_command"`
+	APIURL        string `mapstructure:"api_url"`
+	TeamID        string `mapstructure:"team_id"`
+}
+
+type OAuthConfig struct {
+	ClientID     string   `mapstructure:"client_id"`
+	ClientSecret string   `mapstructure:"client_secret"`
+	AuthURL      string   `mapstructure:"auth_url"`
+	TokenURL     string   `mapstructure:"token_url"`
+	RevokeURL    string   `mapstructure:"revoke_url"`
+	Scopes       []string `mapstructure:"scopes"`
+	RedirectPort int      `mapstructure:"redirect_port"`
+	TokenFile    string   `mapstructure:"token_file"`
+}
+
+// DefaultsConfig holds per-repo defaults for new issues. Team and project
+// accept a name or an ID.
+type DefaultsConfig struct {
+	Team     string   `mapstructure:"team"`
+	Project  string   `mapstructure:"project"`
+	Labels   []string `mapstructure:"labels"`
+	Template string   `mapstructure:"template"`
+}
+
+// TransitionConfig overrides which workflow state, by name or type, each
+// transition verb moves an issue to.
+type TransitionConfig struct {
+	Start  string `mapstructure:"start"`
+	Review string `mapstructure:"review"`
+	Done   string `mapstructure:"done"`
+	Cancel string `mapstructure:"cancel"`
+}
+
+// Keys that must never come from a repo-local file, since those are shared
+// through version control.
+var repoForbiddenKeys = []string{
+	"linear.api_key",
+	"linear.api_key_command",
+	"oauth.client_secret",
+	"oauth.token_file",
+}
+
+// Entry is one effective configuration value and the source that set it.
+type Entry struct {
+	Key    string
+	Value  any
+	Origin string
+}
+
+// Layer is a config file that takes part in the lookup chain.
+type Layer struct {
+	Name string
+	Path string
 }
 
-func New() (*Config, error) {
+// New loads the configuration. When path is empty the layered lookup is
+// used: /etc, $XDG_CONFIG_HOME, $HOME and the working directory, each
+// overriding the previous one, followed by environment variables. An
+// explicit path replaces the file layers but env vars still apply.
+func New(path string) (*Config, error) {
 	v := viper.New()
+	origins := make(map[string]string)
+	repoFile := ""
 
 	v.SetDefault("linear.api_url", "https://api.linear.app/graphql")
 	v.SetDefault("linear.team_id", "")
 	v.SetDefault("linear.api_key", "")
+	v.SetDefault("linear.api_key_command", "")
+	v.SetDefault("oauth.client_id", "")
+	v.SetDefault("oauth.client_secret", "")
+	v.SetDefault("oauth.auth_url", "https://linear.app/oauth/authorize")
+	v.SetDefault("oauth.token_url", "https://api.linear.app/oauth/token")
+	v.SetDefault("oauth.revoke_url", "https://api.linear.app/oauth/revoke")
+	v.SetDefault("oauth.scopes", []string{"read", "write"})
+	v.SetDefault("oauth.redirect_port", 0)
+	v.SetDefault("oauth.token_file", filepath.Join(filepath.Dir(UserFile()), "token.json"))
+	v.SetDefault("defaults.team", "")
+	v.SetDefault("defaults.project", "")
+	v.SetDefault("defaults.labels", []string{})
+	v.SetDefault("defaults.template", "")
+	for _, key := range v.AllKeys() {
+		origins[key] = "default"
+	}
 
-	v.SetConfigName(".lcli")
-	v.SetConfigType("yaml")
-
-	// Where config file is resolved from
-	v.AddConfigPath(".")
-	v.AddConfigPath("$HOME")
+	layers := Layers()
+	if path != "" {
+		layers = []Layer{{Name: "flag", Path: path}}
+	}
 
-	v.SetEnvPrefix("LCLI")
-	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
-	v.AutomaticEnv()
+	for _, layer := range layers {
+		settings, err := readLayer(layer.Path)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) && path == "" {
+				continue
+			}
+			return nil, fmt.Errorf("error reading config file %s: %w", layer.Path, err)
+		}
+		if layer.Name == "repo" {
+			for _, key := range repoForbiddenKeys {
+				if settings.IsSet(key) {
+					return nil, fmt.Errorf("%s must not be set in repo config %s, move it to %s or an env var", key, layer.Path, UserFile())
+				}
+			}
+			repoFile = layer.Path
+		}
+		if err := v.MergeConfigMap(settings.AllSettings()); err != nil {
+			return nil, fmt.Errorf("error merging config file %s: %w", layer.Path, err)
+		}
+		for _, key := range settings.AllKeys() {
+			origins[key] = layer.Path
+		}
+	}
 
-	if err := v.ReadInConfig(); err != nil {
-		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
-			return nil, fmt.Errorf("error reading config file: %w", err)
+	for _, key := range Keys() {
+		// Maps such as transitions can only be set from files.
+		if t, err := fieldType(key); err != nil || t.Kind() == reflect.Map {
+			continue
+		}
+		for _, name := range EnvNames(key) {
+			if value, ok := os.LookupEnv(name); ok {
+				v.Set(key, value)
+				origins[key] = "env " + name
+			}
 		}
 	}
 
-	cfg := &Config{
-		Linear: LinearConfig{
-			APIKey: v.GetString("linear.api_key"),
-			APIURL: v.GetString("linear.api_url"),
-			TeamID: v.GetString("linear.team_id"),
-		},
+	cfg := &Config{v: v, origins: origins, repoFile: repoFile}
+	if err := v.Unmarshal(cfg); err != nil {
+		return nil, fmt.Errorf("error decoding config: %w", err)
 	}
 
 	return cfg, nil
 }
+
+// Layers returns the config files consulted when no explicit file is given,
+// lowest precedence first. The repo layer is the nearest .lcli.yaml between
+// the working directory and the enclosing git root.
+func Layers() []Layer {
+	layers := []Layer{{Name: "system", Path: "/etc/lineartui/config.yaml"}}
+
+	xdg := os.Getenv("XDG_CONFIG_HOME")
+	home, _ := os.UserHomeDir()
+	if xdg == "" && home != "" {
+		xdg = filepath.Join(home, ".config")
+	}
+	if xdg != "" {
+		layers = append(layers, Layer{Name: "xdg", Path: filepath.Join(xdg, "lineartui", "config.yaml")})
+	}
+	homeFile := ""
+	if home != "" {
+		homeFile = filepath.Join(home, ".lcli.yaml")
+		layers = append(layers, Layer{Name: "home", Path: homeFile})
+	}
+	if wd, err := os.Getwd(); err == nil {
+		if repo := FindRepoFile(wd); repo != "" && repo != homeFile {
+			layers = append(layers, Layer{Name: "repo", Path: repo})
+		}
+	}
+
+	return layers
+}
+
+// FindRepoFile walks up from dir looking for .lcli.yaml, stopping at the
+// git root. Outside a git repository only dir itself is checked.
+func FindRepoFile(dir string) string {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return ""
+	}
+	if gitRoot(dir) == "" {
+		candidate := filepath.Join(dir, ".lcli.yaml")
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate
+		}
+		return ""
+	}
+
+	for {
+		candidate := filepath.Join(dir, ".lcli.yaml")
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate
+		}
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+			return ""
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// TemplateDirs returns the directories issue templates are read from,
+// highest precedence first: the nearest .lineartui/templates between the
+// working directory and the git root, then templates/ beside UserFile.
+func TemplateDirs() []string {
+	var dirs []string
+	if wd, err := os.Getwd(); err == nil {
+		if repo := findRepoTemplates(wd); repo != "" {
+			dirs = append(dirs, repo)
+		}
+	}
+	return append(dirs, filepath.Join(filepath.Dir(UserFile()), "templates"))
+}
+
+func findRepoTemplates(dir string) string {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return ""
+	}
+	root := gitRoot(dir)
+	for {
+		candidate := filepath.Join(dir, ".lineartui", "templates")
+		if info, err := os.Stat(candidate); err == nil && info.IsDir() {
+			return candidate
+		}
+		if root == "" || dir == root {
+			return ""
+		}
+		dir = filepath.Dir(dir)
+	}
+}
+
+func gitRoot(dir string) string {
+	for {
+		// .git is a directory in a normal checkout and a file in a worktree.
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+			return dir
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+func readLayer(path string) (*viper.Viper, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+	layer := viper.New()
+	layer.SetConfigFile(path)
+	layer.SetConfigType("yaml")
+	if err := layer.ReadInConfig(); err != nil {
+		return nil, err
+	}
+	return layer, nil
+}
+
+// Keys lists every dotted config key declared on Config.
+func Keys() []string {
+	return collectKeys(reflect.TypeOf(Config{}), "")
+}
+
+func collectKeys(t reflect.Type, prefix string) []string {
+	var keys []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("mapstructure")
+		if !field.IsExported() || tag == "" || tag == "-" {
+			continue
+		}
+		key := prefix + tag
+		if field.Type.Kind() == reflect.Struct {
+			keys = append(keys, collectKeys(field.Type, key+".")...)
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// EnvNames returns the environment variables that can set key, in
+// increasing order of precedence.
+func EnvNames(key string) []string {
+	suffix := strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
+	names := make([]string, 0, len(envPrefixes))
+	for _, prefix := range envPrefixes {
+		names = append(names, prefix+"_"+suffix)
+	}
+	return names
+}
+
+// Entries returns every effective value together with where it came from,
+// sorted by key.
+func (c *Config) Entries() []Entry {
+	keys := c.v.AllKeys()
+	sort.Strings(keys)
+
+	entries := make([]Entry, 0, len(keys))
+	for _, key := range keys {
+		origin, ok := c.origins[key]
+		if !ok {
+			origin = "default"
+		}
+		entries = append(entries, Entry{Key: key, Value: c.v.Get(key), Origin: origin})
+	}
+	return entries
+}
+
+// Origin reports which layer supplied key.
+func (c *Config) Origin(key string) string {
+	if origin, ok := c.origins[key]; ok {
+		return origin
+	}
+	return "default"
+}
+
+// RepoFile is the repo-local config file in effect, or "" when none was found.
+func (c *Config) RepoFile() string {
+	return c.repoFile
+}
+
+// Get returns the effective value for key.
+func (c *Config) Get(key string) any {
+	return c.v.Get(key)
+}
+
+// ResolveAPIKey returns the API key, running linear.api_key_command when no
+// plaintext key is configured. The command's output is kept in memory for
+// the rest of the process and never written back to disk.
+func (c *Config) ResolveAPIKey() (string, error) {
+	if c.Linear.APIKey != "" || c.Linear.APIKeyCommand == "" {
+		return c.Linear.APIKey, nil
+	}
+
+	var stdout, stderr bytes.Buffer
+	command := exec.Command("sh", "-c", c.Linear.APIKeyCommand)
+	command.Stdout = &stdout
+	command.Stderr = &stderr
+	command.Stdin = os.Stdin
+	if err := command.Run(); err != nil {
+		return "", fmt.Errorf("linear.api_key_command failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	key := strings.TrimSpace(stdout.String())
+	if key == "" {
+		return "", errors.New("linear.api_key_command printed no API key")
+	}
+	c.Linear.APIKey = key
+	return key, nil
+}
+
+// Warnings reports insecure settings, such as a plaintext API key stored in
+// a file that other users can read.
+func (c *Config) Warnings() []string {
+	var warnings []string
+	if c.Linear.APIKey == "" {
+		return warnings
+	}
+	origin := c.Origin("linear.api_key")
+	if origin == "default" || strings.HasPrefix(origin, "env ") {
+		return warnings
+	}
+	info, err := os.Stat(origin)
+	if err != nil {
+		return warnings
+	}
+	if info.Mode().Perm()&0o077 != 0 {
+		warnings = append(warnings, fmt.Sprintf(
+			"%s holds a plaintext API key and is readable by other users (mode %#o); run chmod 600 %s or use linear.api_key_command",
+			origin, info.Mode().Perm(), origin))
+	}
+	return warnings
+}
+
+// Transition returns the configured target state for verb on a team,
+// checking the team key, then the team ID, then the "default" entry.
+func (c *Config) Transition(teamKey string, teamID string, verb string) string {
+	for _, name := range []string{teamKey, teamID, "default"} {
+		t, ok := c.Transitions[strings.ToLower(name)]
+		if !ok {
+			continue
+		}
+		var target string
+		switch verb {
+		case "start":
+			target = t.Start
+		case "review":
+			target = t.Review
+		case "done":
+			target = t.Done
+		case "cancel":
+			target = t.Cancel
+		}
+		if target != "" {
+			return target
+		}
+	}
+	return ""
+}
+
+// View returns the filter expression saved under name. View names are
+// matched case-insensitively since the config loader lowercases keys.
+func (c *Config) View(name string) (string, bool) {
+	expr, ok := c.Views[strings.ToLower(name)]
+	return expr, ok
+}
@@ -0,0 +1,223 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// File is a single config file opened for editing. Unlike New it sees only
+// the values stored in that file, never defaults or other layers.
+type File struct {
+	Path string
+	data map[string]any
+}
+
+// UserFile is the per-user config file `config init` and `config set` write
+// to when neither --configfile nor an existing ~/.lcli.yaml says otherwise.
+func UserFile() string {
+	xdg := os.Getenv("XDG_CONFIG_HOME")
+	if xdg == "" {
+		home, _ := os.UserHomeDir()
+		xdg = filepath.Join(home, ".config")
+	}
+	return filepath.Join(xdg, "lineartui", "config.yaml")
+}
+
+// LoadFile reads path for editing. A missing file yields an empty File.
+func LoadFile(path string) (*File, error) {
+	f := &File{Path: path, data: make(map[string]any)}
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return f, nil
+		}
+		return nil, fmt.Errorf("error reading config file %s: %w", path, err)
+	}
+	if err := yaml.Unmarshal(raw, &f.data); err != nil {
+		return nil, fmt.Errorf("error parsing config file %s: %w", path, err)
+	}
+	if f.data == nil {
+		f.data = make(map[string]any)
+	}
+	return f, nil
+}
+
+// Get returns the value stored in this file for key.
+func (f *File) Get(key string) (any, bool) {
+	parts := strings.Split(key, ".")
+	node := f.data
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := node[part].(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		node = next
+	}
+	value, ok := node[parts[len(parts)-1]]
+	return value, ok
+}
+
+// Set validates key against Config, converts value to the field's type and
+// stores it.
+func (f *File) Set(key string, value string) error {
+	field, err := fieldType(key)
+	if err != nil {
+		return err
+	}
+	converted, err := convertValue(field, value)
+	if err != nil {
+		return fmt.Errorf("invalid value for %s: %w", key, err)
+	}
+
+	parts := strings.Split(key, ".")
+	node := f.data
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := node[part].(map[string]any)
+		if !ok {
+			next = make(map[string]any)
+			node[part] = next
+		}
+		node = next
+	}
+	node[parts[len(parts)-1]] = converted
+	return nil
+}
+
+// Unset removes key from the file, pruning sections left empty.
+func (f *File) Unset(key string) error {
+	if _, err := fieldType(key); err != nil {
+		return err
+	}
+	unsetPath(f.data, strings.Split(key, "."))
+	return nil
+}
+
+func unsetPath(node map[string]any, parts []string) {
+	if len(parts) == 1 {
+		delete(node, parts[0])
+		return
+	}
+	next, ok := node[parts[0]].(map[string]any)
+	if !ok {
+		return
+	}
+	unsetPath(next, parts[1:])
+	if len(next) == 0 {
+		delete(node, parts[0])
+	}
+}
+
+// Validate reports keys in the file that Config does not declare.
+func (f *File) Validate() error {
+	var unknown []string
+	collectUnknown(f.data, reflect.TypeOf(Config{}), "", &unknown)
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("unknown config keys in %s: %s", f.Path, strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+func collectUnknown(node map[string]any, t reflect.Type, prefix string, unknown *[]string) {
+	for key, value := range node {
+		field, ok := fieldByTag(t, key)
+		if !ok {
+			*unknown = append(*unknown, prefix+key)
+			continue
+		}
+		if child, ok := value.(map[string]any); ok && field.Type.Kind() == reflect.Struct {
+			collectUnknown(child, field.Type, prefix+key+".", unknown)
+		}
+	}
+}
+
+// Save writes the file with 0600 permissions, creating parent directories.
+func (f *File) Save() error {
+	if err := os.MkdirAll(filepath.Dir(f.Path), 0o700); err != nil {
+		return fmt.Errorf("error creating config directory: %w", err)
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(f.data); err != nil {
+		return fmt.Errorf("error encoding config: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return fmt.Errorf("error encoding config: %w", err)
+	}
+
+	if err := os.WriteFile(f.Path, buf.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("error writing config file %s: %w", f.Path, err)
+	}
+	// WriteFile keeps the mode of an existing file, so tighten it explicitly.
+	return os.Chmod(f.Path, 0o600)
+}
+
+// ValidateKey returns an error naming the valid keys when key is unknown.
+func ValidateKey(key string) error {
+	_, err := fieldType(key)
+	return err
+}
+
+func fieldType(key string) (reflect.Type, error) {
+	t := reflect.TypeOf(Config{})
+	for _, part := range strings.Split(key, ".") {
+		if t.Kind() != reflect.Struct {
+			return nil, unknownKeyError(key)
+		}
+		field, ok := fieldByTag(t, part)
+		if !ok {
+			return nil, unknownKeyError(key)
+		}
+		t = field.Type
+	}
+	if t.Kind() == reflect.Struct {
+		return nil, unknownKeyError(key)
+	}
+	return t, nil
+}
+
+func fieldByTag(t reflect.Type, tag string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.IsExported() && field.Tag.Get("mapstructure") == tag {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+func unknownKeyError(key string) error {
+	return fmt.Errorf("unknown config key %q, valid keys are: %s", key, strings.Join(Keys(), ", "))
+}
+
+func convertValue(t reflect.Type, value string) (any, error) {
+	switch t.Kind() {
+	case reflect.String:
+		return value, nil
+	case reflect.Bool:
+		return strconv.ParseBool(value)
+	case reflect.Int, reflect.Int64:
+		return strconv.Atoi(value)
+	case reflect.Slice:
+		var items []string
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		return items, nil
+	}
+	return nil, fmt.Errorf("cannot set values of type %s from the command line", t)
+}
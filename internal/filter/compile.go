@@ -0,0 +1,293 @@
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Resolver supplies lookups the compiler cannot do on its own.
+type Resolver struct {
+	// User maps a name or email to a user ID.
+	User func(name string) (string, error)
+	// Now anchors relative dates such as updated:>7d and due:<7d.
+	Now time.Time
+}
+
+var stateTypes = map[string]string{
+	"triage":    "triage",
+	"backlog":   "backlog",
+	"unstarted": "unstarted",
+	"started":   "started",
+	"completed": "completed",
+	"done":      "completed",
+	"canceled":  "canceled",
+	"cancelled": "canceled",
+}
+
+var priorities = map[string]int{
+	"none":   0,
+	"urgent": 1,
+	"high":   2,
+	"medium": 3,
+	"low":    4,
+}
+
+// Fields lists the field names the language understands; status is an
+// alias of state.
+var Fields = []string{"state", "status", "assignee", "creator", "label", "priority", "project", "team", "cycle", "title", "estimate", "updated", "created", "completed", "due"}
+
+// Compile turns the query into an IssueFilter input. Multiple terms are
+// combined with AND.
+func (q *Query) Compile(res Resolver) (map[string]any, error) {
+	if res.Now.IsZero() {
+		res.Now = time.Now()
+	}
+
+	var parts []map[string]any
+	for _, term := range q.Terms {
+		part, err := q.compileTerm(term, res)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, part)
+	}
+
+	switch len(parts) {
+	case 0:
+		return map[string]any{}, nil
+	case 1:
+		return parts[0], nil
+	}
+	and := make([]any, len(parts))
+	for i, part := range parts {
+		and[i] = part
+	}
+	return map[string]any{"and": and}, nil
+}
+
+func (q *Query) compileTerm(term Term, res Resolver) (map[string]any, error) {
+	if term.Op != "" {
+		switch term.Field {
+		case "priority", "estimate", "cycle", "updated", "created", "completed", "due":
+		default:
+			return nil, q.errorAt(term, "%q does not support %s comparisons", term.Field, term.Op)
+		}
+	}
+
+	value := term.Value
+	switch term.Field {
+	case "state", "status":
+		if stateType, ok := stateTypes[strings.ToLower(value)]; ok {
+			return map[string]any{"state": map[string]any{"type": eq(stateType, term.Negate)}}, nil
+		}
+		return map[string]any{"state": map[string]any{"name": eqIgnoreCase(value, term.Negate)}}, nil
+
+	case "assignee", "creator":
+		return q.compileUser(term, res)
+
+	case "label":
+		match := map[string]any{"name": eqIgnoreCase(value, false)}
+		if term.Negate {
+			return map[string]any{"labels": map[string]any{"none": match}}, nil
+		}
+		return map[string]any{"labels": map[string]any{"some": match}}, nil
+
+	case "priority":
+		priority, ok := priorities[strings.ToLower(value)]
+		if !ok {
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 0 || n > 4 {
+				return nil, q.errorAt(term, "priority must be 0-4 or none/urgent/high/medium/low, got %q", value)
+			}
+			priority = n
+		}
+		return comparePriority(term, priority), nil
+
+	case "estimate":
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, q.errorAt(term, "estimate must be a number, got %q", value)
+		}
+		return map[string]any{"estimate": compare(term, n)}, nil
+
+	case "project":
+		if strings.EqualFold(value, "none") {
+			return map[string]any{"project": map[string]any{"null": !term.Negate}}, nil
+		}
+		return map[string]any{"project": map[string]any{"name": eqIgnoreCase(value, term.Negate)}}, nil
+
+	case "team":
+		// A team matches by key or name, so the negation has to miss both.
+		join := "or"
+		if term.Negate {
+			join = "and"
+		}
+		return map[string]any{"team": map[string]any{join: []any{
+			map[string]any{"key": eqIgnoreCase(value, term.Negate)},
+			map[string]any{"name": eqIgnoreCase(value, term.Negate)},
+		}}}, nil
+
+	case "cycle":
+		switch strings.ToLower(value) {
+		case "current", "active":
+			return map[string]any{"cycle": map[string]any{"isActive": eq(true, term.Negate)}}, nil
+		case "next":
+			return map[string]any{"cycle": map[string]any{"isNext": eq(true, term.Negate)}}, nil
+		case "none":
+			return map[string]any{"cycle": map[string]any{"null": !term.Negate}}, nil
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, q.errorAt(term, "cycle must be a number, current, next or none, got %q", value)
+		}
+		return map[string]any{"cycle": map[string]any{"number": compare(term, n)}}, nil
+
+	case "title":
+		if term.Negate {
+			return map[string]any{"title": map[string]any{"notContainsIgnoreCase": value}}, nil
+		}
+		return map[string]any{"title": map[string]any{"containsIgnoreCase": value}}, nil
+
+	case "updated", "created", "completed", "due":
+		return q.compileDate(term, res)
+	}
+
+	return nil, q.errorAt(term, "unknown field %q, valid fields are: %s", term.Field, strings.Join(Fields, ", "))
+}
+
+func (q *Query) compileUser(term Term, res Resolver) (map[string]any, error) {
+	value := strings.ToLower(term.Value)
+	var match map[string]any
+	switch value {
+	case "@me", "me":
+		match = map[string]any{"isMe": eq(true, term.Negate)}
+	case "none":
+		if term.Field == "creator" {
+			return nil, q.errorAt(term, "every issue has a creator")
+		}
+		match = map[string]any{"null": !term.Negate}
+	default:
+		if res.User == nil {
+			return nil, q.errorAt(term, "cannot resolve user %q", term.Value)
+		}
+		id, err := res.User(term.Value)
+		if err != nil {
+			return nil, q.errorAt(term, "%s", err)
+		}
+		match = map[string]any{"id": eq(id, term.Negate)}
+	}
+	return map[string]any{term.Field: match}, nil
+}
+
+func (q *Query) compileDate(term Term, res Resolver) (map[string]any, error) {
+	// Due dates lie ahead, so their durations count forward from now and a
+	// bare value means "due by": due:7d and due:<=7d are due within the
+	// next week, overdue issues included. For the other fields durations
+	// count back and a bare value means "since", so updated:7d is updated
+	// within the last week.
+	due := term.Field == "due"
+	field, op := term.Field+"At", ">="
+	if due {
+		field, op = "dueDate", "<="
+	}
+	if term.Op != "" {
+		op = term.Op
+	}
+	if term.Negate {
+		op = map[string]string{"<": ">=", "<=": ">", ">": "<=", ">=": "<"}[op]
+	}
+	comparator := map[string]string{"<": "lt", "<=": "lte", ">": "gt", ">=": "gte"}[op]
+
+	at, err := parseDate(term.Value, res.Now, due)
+	if err != nil {
+		return nil, q.errorAt(term, "%s", err)
+	}
+	// dueDate is a TimelessDate and only compares against plain dates.
+	value := at.UTC().Format(time.RFC3339)
+	if due {
+		value = at.Format("2006-01-02")
+	}
+	return map[string]any{field: map[string]any{comparator: value}}, nil
+}
+
+// parseDate accepts a date (2006-01-02) or a duration such as 36h, 7d or
+// 2w, counted back from now, or forward when ahead is set.
+func parseDate(value string, now time.Time, ahead bool) (time.Time, error) {
+	if t, err := time.ParseInLocation("2006-01-02", value, now.Location()); err == nil {
+		return t, nil
+	}
+	sign := -1
+	if ahead {
+		sign = 1
+	}
+	if len(value) >= 2 {
+		n, err := strconv.Atoi(value[:len(value)-1])
+		if err == nil && n >= 0 {
+			n *= sign
+			switch value[len(value)-1] {
+			case 'h':
+				return now.Add(time.Duration(n) * time.Hour), nil
+			case 'd':
+				return now.AddDate(0, 0, n), nil
+			case 'w':
+				return now.AddDate(0, 0, 7*n), nil
+			}
+		}
+	}
+	return time.Time{}, fmt.Errorf("expected a date like 2006-01-02 or a duration like 7d, got %q", value)
+}
+
+// comparePriority compares priorities by number, except that no priority
+// (0) ranks below low: priority:<=high is urgent or high, and its negation
+// keeps the issues without a priority.
+func comparePriority(term Term, priority int) map[string]any {
+	cmp := compare(term, priority)
+	if term.Op != "<" && term.Op != "<=" {
+		return map[string]any{"priority": cmp}
+	}
+	if !term.Negate {
+		cmp["gte"] = 1
+		return map[string]any{"priority": cmp}
+	}
+	return map[string]any{"or": []any{
+		map[string]any{"priority": cmp},
+		map[string]any{"priority": map[string]any{"eq": 0}},
+	}}
+}
+
+func eq(value any, negate bool) map[string]any {
+	if negate {
+		return map[string]any{"neq": value}
+	}
+	return map[string]any{"eq": value}
+}
+
+func eqIgnoreCase(value string, negate bool) map[string]any {
+	if negate {
+		return map[string]any{"neqIgnoreCase": value}
+	}
+	return map[string]any{"eqIgnoreCase": value}
+}
+
+// compare builds a number comparator from the term's operator.
+func compare(term Term, value any) map[string]any {
+	op := term.Op
+	if term.Negate {
+		op = map[string]string{"": "!", "<": ">=", "<=": ">", ">": "<=", ">=": "<"}[op]
+	}
+	switch op {
+	case "<":
+		return map[string]any{"lt": value}
+	case "<=":
+		return map[string]any{"lte": value}
+	case ">":
+		return map[string]any{"gt": value}
+	case ">=":
+		return map[string]any{"gte": value}
+	case "!":
+		return map[string]any{"neq": value}
+	}
+	return map[string]any{"eq": value}
+}
@@ -0,0 +1,154 @@
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type m = map[string]any
+
+var testResolver = Resolver{
+	User: func(name string) (string, error) {
+		if name == "ada@example.com" {
+			return "u1", nil
+		}
+		return "", fmt.Errorf("no user %q", name)
+	},
+	Now: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+}
+
+func TestCompile(t *testing.T) {
+	tests := []struct {
+		input string
+		want  map[string]any
+	}{
+		{"", m{}},
+		{"state:started", m{"state": m{"type": m{"eq": "started"}}}},
+		{"state:done", m{"state": m{"type": m{"eq": "completed"}}}},
+		{"status:done", m{"state": m{"type": m{"eq": "completed"}}}},
+		{`state:"In Review"`, m{"state": m{"name": m{"eqIgnoreCase": "In Review"}}}},
+		{"state:!done", m{"state": m{"type": m{"neq": "completed"}}}},
+		{"-state:Review", m{"state": m{"name": m{"neqIgnoreCase": "Review"}}}},
+		{"assignee:@me", m{"assignee": m{"isMe": m{"eq": true}}}},
+		{"assignee:none", m{"assignee": m{"null": true}}},
+		{"-assignee:none", m{"assignee": m{"null": false}}},
+		{"creator:ada@example.com", m{"creator": m{"id": m{"eq": "u1"}}}},
+		{"label:bug", m{"labels": m{"some": m{"name": m{"eqIgnoreCase": "bug"}}}}},
+		{"-label:wontfix", m{"labels": m{"none": m{"name": m{"eqIgnoreCase": "wontfix"}}}}},
+		{"priority:high", m{"priority": m{"eq": 2}}},
+		{"priority:<=2", m{"priority": m{"lte": 2, "gte": 1}}},
+		{"priority:<medium", m{"priority": m{"lt": 3, "gte": 1}}},
+		{"-priority:<=2", m{"or": []any{m{"priority": m{"gt": 2}}, m{"priority": m{"eq": 0}}}}},
+		{"priority:>=3", m{"priority": m{"gte": 3}}},
+		{"-priority:>2", m{"priority": m{"lte": 2}}},
+		{"priority:!urgent", m{"priority": m{"neq": 1}}},
+		{"estimate:>=3", m{"estimate": m{"gte": 3.0}}},
+		{"project:none", m{"project": m{"null": true}}},
+		{`project:"Q4 Infra"`, m{"project": m{"name": m{"eqIgnoreCase": "Q4 Infra"}}}},
+		{"team:ENG", m{"team": m{"or": []any{m{"key": m{"eqIgnoreCase": "ENG"}}, m{"name": m{"eqIgnoreCase": "ENG"}}}}}},
+		{"-team:ENG", m{"team": m{"and": []any{m{"key": m{"neqIgnoreCase": "ENG"}}, m{"name": m{"neqIgnoreCase": "ENG"}}}}}},
+		{"-team:Engineering", m{"team": m{"and": []any{m{"key": m{"neqIgnoreCase": "Engineering"}}, m{"name": m{"neqIgnoreCase": "Engineering"}}}}}},
+		{"cycle:current", m{"cycle": m{"isActive": m{"eq": true}}}},
+		{"cycle:>4", m{"cycle": m{"number": m{"gt": 4}}}},
+		{"login", m{"title": m{"containsIgnoreCase": "login"}}},
+		{"-title:wip", m{"title": m{"notContainsIgnoreCase": "wip"}}},
+		{"updated:7d", m{"updatedAt": m{"gte": "2026-10-11T12:00:00Z"}}},
+		{"updated:>36h", m{"updatedAt": m{"gt": "2026-10-17T00:00:00Z"}}},
+		{"created:<2026-10-01", m{"createdAt": m{"lt": "2026-10-01T00:00:00Z"}}},
+		{"-completed:2w", m{"completedAt": m{"lt": "2026-10-04T12:00:00Z"}}},
+		{"due:7d", m{"dueDate": m{"lte": "2026-10-25"}}},
+		{"due:<7d", m{"dueDate": m{"lt": "2026-10-25"}}},
+		{"due:>2w", m{"dueDate": m{"gt": "2026-11-01"}}},
+		{"due:2026-11-01", m{"dueDate": m{"lte": "2026-11-01"}}},
+		{"-due:0d", m{"dueDate": m{"gt": "2026-10-18"}}},
+		{"state:started label:bug", m{"and": []any{
+			m{"state": m{"type": m{"eq": "started"}}},
+			m{"labels": m{"some": m{"name": m{"eqIgnoreCase": "bug"}}}},
+		}}},
+	}
+	for _, tt := range tests {
+		query, err := Parse(tt.input)
+		if err != nil {
+			t.Errorf("Parse(%q) error: %v", tt.input, err)
+			continue
+		}
+		got, err := query.Compile(testResolver)
+		if err != nil {
+			t.Errorf("Compile(%q) error: %v", tt.input, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Compile(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		pos, len int
+		msg      string
+	}{
+		{"state:done colour:red", 11, 10, `unknown field "colour", valid fields are: ` + strings.Join(Fields, ", ")},
+		{"label:<bug", 0, 10, `"label" does not support < comparisons`},
+		{"state:done priority:9", 11, 10, `priority must be 0-4 or none/urgent/high/medium/low, got "9"`},
+		{"estimate:big", 0, 12, `estimate must be a number, got "big"`},
+		{"cycle:soon", 0, 10, `cycle must be a number, current, next or none, got "soon"`},
+		{"updated:>yesterday", 0, 18, `expected a date like 2006-01-02 or a duration like 7d, got "yesterday"`},
+		{"due:-3d", 0, 7, `expected a date like 2006-01-02 or a duration like 7d, got "-3d"`},
+		{"creator:none", 0, 12, "every issue has a creator"},
+		{"label:bug assignee:bob", 10, 12, `no user "bob"`},
+	}
+	for _, tt := range tests {
+		query, err := Parse(tt.input)
+		if err != nil {
+			t.Errorf("Parse(%q) error: %v", tt.input, err)
+			continue
+		}
+		_, err = query.Compile(testResolver)
+		var syntaxErr *SyntaxError
+		if !errors.As(err, &syntaxErr) {
+			t.Errorf("Compile(%q) error = %v, want a SyntaxError", tt.input, err)
+			continue
+		}
+		if syntaxErr.Pos != tt.pos || syntaxErr.Len != tt.len || syntaxErr.Msg != tt.msg {
+			t.Errorf("Compile(%q) error at %d+%d %q, want %d+%d %q", tt.input, syntaxErr.Pos, syntaxErr.Len, syntaxErr.Msg, tt.pos, tt.len, tt.msg)
+		}
+	}
+}
+
+func TestCompileAddedTerms(t *testing.T) {
+	query, err := Parse("label:bug")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := query.Add("updated", ">=3d", "--since 3d"); err != nil {
+		t.Fatal(err)
+	}
+	got, err := query.Compile(testResolver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := m{"and": []any{
+		m{"labels": m{"some": m{"name": m{"eqIgnoreCase": "bug"}}}},
+		m{"updatedAt": m{"gte": "2026-10-15T12:00:00Z"}},
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Compile = %v, want %v", got, want)
+	}
+
+	// Errors in terms from flags name the flag, since they have no
+	// position in the expression.
+	if err := query.Add("priority", "9", "--priority 9"); err != nil {
+		t.Fatal(err)
+	}
+	_, err = query.Compile(testResolver)
+	want2 := `invalid filter: priority must be 0-4 or none/urgent/high/medium/low, got "9" (from --priority 9)`
+	if err == nil || err.Error() != want2 {
+		t.Errorf("Compile error = %v, want %s", err, want2)
+	}
+}
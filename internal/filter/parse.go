@@ -0,0 +1,170 @@
+// Package filter parses the issue filter language used by `issues list`,
+// e.g. `state:started assignee:@me label:bug priority:<=2 -label:wontfix`,
+// and compiles it into Linear's IssueFilter input.
+package filter
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Query is a parsed filter expression. Terms added from typed flags have
+// no position in Input.
+type Query struct {
+	Input string
+	Terms []Term
+}
+
+// Term is one `field:value` condition. Bare words become title terms.
+type Term struct {
+	Field  string
+	Op     string // "", "<", "<=", ">", ">=" or "!"
+	Value  string
+	Negate bool
+
+	// Pos and Raw locate the term in the source for error messages; Pos is
+	// -1 for terms that did not come from the expression.
+	Pos int
+	Raw string
+}
+
+// SyntaxError points at the token that could not be parsed or compiled.
+type SyntaxError struct {
+	Input string
+	Pos   int
+	Len   int
+	Msg   string
+}
+
+func (e *SyntaxError) Error() string {
+	if e.Input == "" {
+		return "invalid filter: " + e.Msg
+	}
+	width := e.Len
+	if width < 1 {
+		width = 1
+	}
+	return fmt.Sprintf("invalid filter: %s\n  %s\n  %s%s", e.Msg, e.Input, strings.Repeat(" ", e.Pos), strings.Repeat("^", width))
+}
+
+// Parse splits input into terms. Values may be double-quoted to include
+// spaces, e.g. project:"Q4 Infra".
+func Parse(input string) (*Query, error) {
+	var terms []Term
+	i := 0
+	for i < len(input) {
+		if unicode.IsSpace(rune(input[i])) {
+			i++
+			continue
+		}
+		start := i
+		raw, next, err := readToken(input, i)
+		if err != nil {
+			return nil, err
+		}
+		i = next
+
+		term, err := parseTerm(raw)
+		if err != nil {
+			return nil, &SyntaxError{Input: input, Pos: start, Len: len(raw), Msg: err.Error()}
+		}
+		term.Pos = start
+		term.Raw = raw
+		terms = append(terms, term)
+	}
+	return &Query{Input: input, Terms: terms}, nil
+}
+
+// Add appends a condition built outside the expression, such as from a
+// typed flag. raw is shown in error messages.
+func (q *Query) Add(field string, value string, raw string) error {
+	term, err := parseTerm(field + ":" + value)
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", raw, err)
+	}
+	term.Pos = -1
+	term.Raw = raw
+	q.Terms = append(q.Terms, term)
+	return nil
+}
+
+// errorAt builds a SyntaxError for term.
+func (q *Query) errorAt(term Term, format string, args ...any) error {
+	msg := fmt.Sprintf(format, args...)
+	if term.Pos < 0 {
+		return &SyntaxError{Msg: fmt.Sprintf("%s (from %s)", msg, term.Raw)}
+	}
+	return &SyntaxError{Input: q.Input, Pos: term.Pos, Len: len(term.Raw), Msg: msg}
+}
+
+// readToken reads up to the next unquoted space.
+func readToken(input string, start int) (string, int, error) {
+	i := start
+	inQuote := false
+	quoteAt := 0
+	for i < len(input) {
+		c := input[i]
+		if c == '"' {
+			if !inQuote {
+				quoteAt = i
+			}
+			inQuote = !inQuote
+		} else if !inQuote && unicode.IsSpace(rune(c)) {
+			break
+		}
+		i++
+	}
+	if inQuote {
+		return "", 0, &SyntaxError{Input: input, Pos: quoteAt, Len: 1, Msg: "unterminated quote"}
+	}
+	return input[start:i], i, nil
+}
+
+func parseTerm(raw string) (Term, error) {
+	var term Term
+	rest := raw
+	if strings.HasPrefix(rest, "-") && len(rest) > 1 {
+		term.Negate = true
+		rest = rest[1:]
+	}
+
+	field, value, ok := strings.Cut(rest, ":")
+	if !ok || strings.HasPrefix(rest, `"`) {
+		// A bare word searches titles.
+		if term.Negate {
+			return term, fmt.Errorf("bare words cannot be negated, use -title:%s", rest)
+		}
+		term.Field = "title"
+		term.Value = unquote(rest)
+		return term, nil
+	}
+
+	term.Field = strings.ToLower(field)
+	if term.Field == "" {
+		return term, fmt.Errorf("missing field name before ':'")
+	}
+	for _, op := range []string{"<=", ">=", "<", ">", "!"} {
+		if strings.HasPrefix(value, op) {
+			term.Op = op
+			value = value[len(op):]
+			break
+		}
+	}
+	if term.Op == "!" {
+		term.Negate = !term.Negate
+		term.Op = ""
+	}
+	term.Value = unquote(value)
+	if term.Value == "" {
+		return term, fmt.Errorf("missing value for %q", term.Field)
+	}
+	return term, nil
+}
+
+func unquote(s string) string {
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
@@ -0,0 +1,76 @@
+package filter
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []Term
+	}{
+		{"", nil},
+		{"state:started", []Term{{Field: "state", Value: "started", Pos: 0, Raw: "state:started"}}},
+		{"  State:Done  label:bug", []Term{
+			{Field: "state", Value: "Done", Pos: 2, Raw: "State:Done"},
+			{Field: "label", Value: "bug", Pos: 14, Raw: "label:bug"},
+		}},
+		{`project:"Q4 Infra"`, []Term{{Field: "project", Value: "Q4 Infra", Pos: 0, Raw: `project:"Q4 Infra"`}}},
+		{`project:!"Q4 Infra"`, []Term{{Field: "project", Value: "Q4 Infra", Negate: true, Pos: 0, Raw: `project:!"Q4 Infra"`}}},
+		{"-label:wontfix", []Term{{Field: "label", Value: "wontfix", Negate: true, Pos: 0, Raw: "-label:wontfix"}}},
+		{"state:!done", []Term{{Field: "state", Value: "done", Negate: true, Pos: 0, Raw: "state:!done"}}},
+		// Both negations cancel out.
+		{"-state:!done", []Term{{Field: "state", Value: "done", Pos: 0, Raw: "-state:!done"}}},
+		{"priority:<=2", []Term{{Field: "priority", Op: "<=", Value: "2", Pos: 0, Raw: "priority:<=2"}}},
+		{"updated:>7d", []Term{{Field: "updated", Op: ">", Value: "7d", Pos: 0, Raw: "updated:>7d"}}},
+		{"-priority:<3", []Term{{Field: "priority", Op: "<", Value: "3", Negate: true, Pos: 0, Raw: "-priority:<3"}}},
+		{"login", []Term{{Field: "title", Value: "login", Pos: 0, Raw: "login"}}},
+		{`"fails on: safari"`, []Term{{Field: "title", Value: "fails on: safari", Pos: 0, Raw: `"fails on: safari"`}}},
+		{"-", []Term{{Field: "title", Value: "-", Pos: 0, Raw: "-"}}},
+	}
+	for _, tt := range tests {
+		query, err := Parse(tt.input)
+		if err != nil {
+			t.Errorf("Parse(%q) error: %v", tt.input, err)
+			continue
+		}
+		if !reflect.DeepEqual(query.Terms, tt.want) {
+			t.Errorf("Parse(%q) = %+v, want %+v", tt.input, query.Terms, tt.want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		pos, len int
+		msg      string
+	}{
+		{`state:done project:"Q4 Infra`, 19, 1, "unterminated quote"},
+		{"label:bug state:", 10, 6, `missing value for "state"`},
+		{"label:bug :x", 10, 2, "missing field name before ':'"},
+		{"state:done -login", 11, 6, "bare words cannot be negated, use -title:login"},
+		{`title:"a b" state:"`, 18, 1, "unterminated quote"},
+	}
+	for _, tt := range tests {
+		_, err := Parse(tt.input)
+		var syntaxErr *SyntaxError
+		if !errors.As(err, &syntaxErr) {
+			t.Errorf("Parse(%q) error = %v, want a SyntaxError", tt.input, err)
+			continue
+		}
+		if syntaxErr.Pos != tt.pos || syntaxErr.Len != tt.len || syntaxErr.Msg != tt.msg {
+			t.Errorf("Parse(%q) error at %d+%d %q, want %d+%d %q", tt.input, syntaxErr.Pos, syntaxErr.Len, syntaxErr.Msg, tt.pos, tt.len, tt.msg)
+		}
+	}
+}
+
+func TestSyntaxErrorPointsAtToken(t *testing.T) {
+	_, err := Parse("label:bug state:")
+	want := "invalid filter: missing value for \"state\"\n  label:bug state:\n            ^^^^^^"
+	if err == nil || err.Error() != want {
+		t.Errorf("error = %q, want %q", err, want)
+	}
+}
@@ -72,3 +72,56 @@ func (c *Client) Request(method, endpoint string, body interface{}) ([]byte, err
 
 	return responseBody, nil
 }
+
+// Upload sends body as-is, e.g. a file to a signed storage URL. size is
+// sent as the Content-Length, which storage services require.
+func (c *Client) Upload(method, endpoint string, body io.Reader, size int64) error {
+	req, err := http.NewRequest(method, c.BaseURL+endpoint, body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.ContentLength = size
+
+	for key, value := range c.Headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		responseBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("upload failed with status %d: %s", resp.StatusCode, string(responseBody))
+	}
+	return nil
+}
+
+// Download streams the body of a GET request into w without holding it in
+// memory.
+func (c *Client) Download(endpoint string, w io.Writer) error {
+	req, err := http.NewRequest(http.MethodGet, c.BaseURL+endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	for key, value := range c.Headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("download failed with status %d", resp.StatusCode)
+	}
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+	return nil
+}